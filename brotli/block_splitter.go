@@ -0,0 +1,138 @@
+package brotli
+
+// maxBlockTypes is the most block types a category can have in one
+// meta-block.
+const maxBlockTypes = 256
+
+// A blockSplit is the division of one category of symbols (literals,
+// commands, or distances) into blocks, each with a block type.
+type blockSplit struct {
+	numTypes int
+	types    []byte
+	lengths  []uint32
+}
+
+// A blockSplitter divides a stream of symbols into blocks in a single greedy
+// pass. Each time it has collected a block's worth of symbols, it compares
+// their entropy with that of the last two block types, and either starts a
+// new block type or adds the symbols to one of those types.
+type blockSplitter struct {
+	minBlockSize   int
+	splitThreshold float64
+
+	split blockSplit
+
+	// histograms has one entry for each block type, plus one for the
+	// block in progress.
+	histograms []histogram
+
+	targetBlockSize int
+	blockSize       int
+	mergeLastCount  int
+
+	// last holds the types of the last two blocks, and lastEntropy their
+	// entropy.
+	last        [2]int
+	lastEntropy [2]float64
+
+	combined [2]histogram
+}
+
+func newBlockSplitter(alphabetSize, minBlockSize int, splitThreshold float64) *blockSplitter {
+	return &blockSplitter{
+		minBlockSize:    minBlockSize,
+		splitThreshold:  splitThreshold,
+		histograms:      []histogram{newHistogram(alphabetSize)},
+		targetBlockSize: minBlockSize,
+		combined:        [2]histogram{newHistogram(alphabetSize), newHistogram(alphabetSize)},
+	}
+}
+
+func (s *blockSplitter) add(symbol int) {
+	s.histograms[s.split.numTypes].add(symbol)
+	s.blockSize++
+	if s.blockSize == s.targetBlockSize {
+		s.finishBlock()
+	}
+}
+
+// finishBlock decides what to do with the symbols collected since the last
+// block was finished.
+func (s *blockSplitter) finishBlock() {
+	split := &s.split
+	current := &s.histograms[split.numTypes]
+	numBlocks := len(split.lengths)
+
+	if numBlocks == 0 {
+		// This is the first block.
+		split.lengths = append(split.lengths, uint32(s.blockSize))
+		split.types = append(split.types, 0)
+		s.lastEntropy[0] = bitsEntropy(current.counts)
+		s.lastEntropy[1] = s.lastEntropy[0]
+		split.numTypes++
+		s.histograms = append(s.histograms, newHistogram(len(current.counts)))
+		s.blockSize = 0
+		return
+	}
+	if s.blockSize == 0 {
+		return
+	}
+
+	entropy := bitsEntropy(current.counts)
+	var combinedEntropy, diff [2]float64
+	for j := range s.combined {
+		s.combined[j].set(current)
+		s.combined[j].addHistogram(&s.histograms[s.last[j]])
+		combinedEntropy[j] = bitsEntropy(s.combined[j].counts)
+		diff[j] = combinedEntropy[j] - entropy - s.lastEntropy[j]
+	}
+
+	switch {
+	case split.numTypes < maxBlockTypes && diff[0] > s.splitThreshold && diff[1] > s.splitThreshold:
+		// Start a new block type.
+		split.lengths = append(split.lengths, uint32(s.blockSize))
+		split.types = append(split.types, byte(split.numTypes))
+		s.last[1] = s.last[0]
+		s.last[0] = split.numTypes
+		s.lastEntropy[1] = s.lastEntropy[0]
+		s.lastEntropy[0] = entropy
+		split.numTypes++
+		s.histograms = append(s.histograms, newHistogram(len(current.counts)))
+		s.mergeLastCount = 0
+		s.targetBlockSize = s.minBlockSize
+
+	case diff[1] < diff[0]-20:
+		// Switch back to the type before the last one.
+		split.lengths = append(split.lengths, uint32(s.blockSize))
+		split.types = append(split.types, split.types[numBlocks-2])
+		s.last[0], s.last[1] = s.last[1], s.last[0]
+		s.histograms[s.last[0]].set(&s.combined[1])
+		s.lastEntropy[1] = s.lastEntropy[0]
+		s.lastEntropy[0] = combinedEntropy[1]
+		current.clear()
+		s.mergeLastCount = 0
+		s.targetBlockSize = s.minBlockSize
+
+	default:
+		// Add the symbols to the last block.
+		split.lengths[numBlocks-1] += uint32(s.blockSize)
+		s.histograms[s.last[0]].set(&s.combined[0])
+		s.lastEntropy[0] = combinedEntropy[0]
+		if split.numTypes == 1 {
+			s.lastEntropy[1] = s.lastEntropy[0]
+		}
+		current.clear()
+		s.mergeLastCount++
+		if s.mergeLastCount > 1 {
+			s.targetBlockSize += s.minBlockSize
+		}
+	}
+	s.blockSize = 0
+}
+
+// finish finishes the last block, and returns the block split and the
+// histogram for each block type.
+func (s *blockSplitter) finish() (*blockSplit, []histogram) {
+	s.finishBlock()
+	return &s.split, s.histograms[:s.split.numTypes]
+}
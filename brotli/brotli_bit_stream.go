@@ -41,6 +41,220 @@ var kBlockLengthPrefixCode = [numBlockLenSymbols]prefixCodeRange{
 	prefixCodeRange{16625, 24},
 }
 
+var storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder = [codeLengthCodes]byte{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+var storeHuffmanTreeOfHuffmanTreeToBitMask_kHuffmanBitLengthHuffmanCodeSymbols = [6]byte{0, 7, 3, 2, 1, 15}
+var storeHuffmanTreeOfHuffmanTreeToBitMask_kHuffmanBitLengthHuffmanCodeBitLengths = [6]byte{2, 4, 3, 2, 2, 4}
+
+func storeHuffmanTreeOfHuffmanTreeToBitMask(num_codes int, code_length_bitdepth []byte, bw *bitWriter) {
+	var skip_some uint = 0
+	var codes_to_store uint = codeLengthCodes
+	/* The bit lengths of the Huffman code over the code length alphabet
+	   are compressed with the following static Huffman code:
+	     Symbol   Code
+	     ------   ----
+	     0          00
+	     1        1110
+	     2         110
+	     3          01
+	     4          10
+	     5        1111 */
+
+	/* Throw away trailing zeros: */
+	if num_codes > 1 {
+		for ; codes_to_store > 0; codes_to_store-- {
+			if code_length_bitdepth[storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder[codes_to_store-1]] != 0 {
+				break
+			}
+		}
+	}
+
+	if code_length_bitdepth[storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder[0]] == 0 && code_length_bitdepth[storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder[1]] == 0 {
+		skip_some = 2 /* skips two. */
+		if code_length_bitdepth[storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder[2]] == 0 {
+			skip_some = 3 /* skips three. */
+		}
+	}
+
+	bw.writeBits(2, uint64(skip_some))
+	{
+		var i uint
+		for i = skip_some; i < codes_to_store; i++ {
+			var l uint = uint(code_length_bitdepth[storeHuffmanTreeOfHuffmanTreeToBitMask_kStorageOrder[i]])
+			bw.writeBits(uint(storeHuffmanTreeOfHuffmanTreeToBitMask_kHuffmanBitLengthHuffmanCodeBitLengths[l]), uint64(storeHuffmanTreeOfHuffmanTreeToBitMask_kHuffmanBitLengthHuffmanCodeSymbols[l]))
+		}
+	}
+}
+
+func storeHuffmanTreeToBitMask(huffman_tree_size uint, huffman_tree []byte, huffman_tree_extra_bits []byte, code_length_bitdepth []byte, code_length_bitdepth_symbols []uint16, bw *bitWriter) {
+	var i uint
+	for i = 0; i < huffman_tree_size; i++ {
+		var ix uint = uint(huffman_tree[i])
+		bw.writeBits(uint(code_length_bitdepth[ix]), uint64(code_length_bitdepth_symbols[ix]))
+
+		/* Extra bits */
+		switch ix {
+		case repeatPreviousCodeLength:
+			bw.writeBits(2, uint64(huffman_tree_extra_bits[i]))
+
+		case repeatZeroCodeLength:
+			bw.writeBits(3, uint64(huffman_tree_extra_bits[i]))
+		}
+	}
+}
+
+func storeSimpleHuffmanTree(depths []byte, symbols []uint, num_symbols uint, max_bits uint, bw *bitWriter) {
+	/* value of 1 indicates a simple Huffman code */
+	bw.writeBits(2, 1)
+
+	bw.writeBits(2, uint64(num_symbols)-1) /* NSYM - 1 */
+	{
+		/* Sort */
+		var i uint
+		for i = 0; i < num_symbols; i++ {
+			var j uint
+			for j = i + 1; j < num_symbols; j++ {
+				if depths[symbols[j]] < depths[symbols[i]] {
+					var tmp uint = symbols[j]
+					symbols[j] = symbols[i]
+					symbols[i] = tmp
+				}
+			}
+		}
+	}
+
+	if num_symbols == 2 {
+		bw.writeBits(max_bits, uint64(symbols[0]))
+		bw.writeBits(max_bits, uint64(symbols[1]))
+	} else if num_symbols == 3 {
+		bw.writeBits(max_bits, uint64(symbols[0]))
+		bw.writeBits(max_bits, uint64(symbols[1]))
+		bw.writeBits(max_bits, uint64(symbols[2]))
+	} else {
+		bw.writeBits(max_bits, uint64(symbols[0]))
+		bw.writeBits(max_bits, uint64(symbols[1]))
+		bw.writeBits(max_bits, uint64(symbols[2]))
+		bw.writeBits(max_bits, uint64(symbols[3]))
+
+		/* tree-select */
+		var tmp int
+		if depths[symbols[0]] == 1 {
+			tmp = 1
+		} else {
+			tmp = 0
+		}
+		bw.writeBits(1, uint64(tmp))
+	}
+}
+
+// num = alphabet size
+// depths = symbol depths
+func storeHuffmanTree(depths []byte, num uint, tree []huffmanTree, bw *bitWriter) {
+	var huffman_tree [maxDistanceSymbols]byte
+	var huffman_tree_extra_bits [maxDistanceSymbols]byte
+	var huffman_tree_size uint = 0
+	var code_length_bitdepth = [codeLengthCodes]byte{0}
+	var code_length_bitdepth_symbols [codeLengthCodes]uint16
+	var huffman_tree_histogram = [codeLengthCodes]uint32{0}
+	var i uint
+	var num_codes int = 0
+	/* Write the Huffman tree into the brotli-representation.
+	   The distance alphabet with the large-window extension is the largest,
+	   so this allocation will fit all alphabets. */
+
+	var code uint = 0
+
+	assert(num <= maxDistanceSymbols)
+
+	writeHuffmanTree(depths, num, &huffman_tree_size, huffman_tree[:], huffman_tree_extra_bits[:])
+
+	/* Calculate the statistics of the Huffman tree in brotli-representation. */
+	for i = 0; i < huffman_tree_size; i++ {
+		huffman_tree_histogram[huffman_tree[i]]++
+	}
+
+	for i = 0; i < codeLengthCodes; i++ {
+		if huffman_tree_histogram[i] != 0 {
+			if num_codes == 0 {
+				code = i
+				num_codes = 1
+			} else if num_codes == 1 {
+				num_codes = 2
+				break
+			}
+		}
+	}
+
+	/* Calculate another Huffman tree to use for compressing both the
+	   earlier Huffman tree with. */
+	createHuffmanTree(huffman_tree_histogram[:], codeLengthCodes, 5, tree, code_length_bitdepth[:])
+
+	convertBitDepthsToSymbols(code_length_bitdepth[:], codeLengthCodes, code_length_bitdepth_symbols[:])
+
+	/* Now, we have all the data, let's start storing it */
+	storeHuffmanTreeOfHuffmanTreeToBitMask(num_codes, code_length_bitdepth[:], bw)
+
+	if num_codes == 1 {
+		code_length_bitdepth[code] = 0
+	}
+
+	/* Store the real Huffman tree now. */
+	storeHuffmanTreeToBitMask(huffman_tree_size, huffman_tree[:], huffman_tree_extra_bits[:], code_length_bitdepth[:], code_length_bitdepth_symbols[:], bw)
+}
+
+// Builds a Huffman tree from histogram[0:length] into depth[0:length] and
+// bits[0:length] and stores the encoded tree to the bit stream.
+func buildAndStoreHuffmanTree(histogram []uint32, histogram_length uint, max_bits uint, depth []byte, bits []uint16, bw *bitWriter) {
+	var count uint = 0
+	var s4 = [4]uint{0}
+	var i uint
+	for i = 0; i < histogram_length; i++ {
+		if histogram[i] != 0 {
+			if count < 4 {
+				s4[count] = i
+			} else if count > 4 {
+				break
+			}
+
+			count++
+		}
+	}
+
+	if count <= 1 {
+		bw.writeBits(4, 1)
+		bw.writeBits(max_bits, uint64(s4[0]))
+		depth[s4[0]] = 0
+		bits[s4[0]] = 0
+		return
+	}
+
+	for i := 0; i < int(histogram_length); i++ {
+		depth[i] = 0
+	}
+
+	/* The tree is also used by storeHuffmanTree for the code length code. */
+	var max_tree_size uint = 2*histogram_length + 1
+	if max_tree_size < 2*codeLengthCodes+1 {
+		max_tree_size = 2*codeLengthCodes + 1
+	}
+	tree, _ := huffmanTreePool.Get().(*[]huffmanTree)
+	if tree == nil || cap(*tree) < int(max_tree_size) {
+		tmp := make([]huffmanTree, max_tree_size)
+		tree = &tmp
+	} else {
+		*tree = (*tree)[:max_tree_size]
+	}
+	createHuffmanTree(histogram, histogram_length, 15, *tree, depth)
+	convertBitDepthsToSymbols(depth, histogram_length, bits)
+
+	if count <= 4 {
+		storeSimpleHuffmanTree(depth, s4[:], count, max_bits, bw)
+	} else {
+		storeHuffmanTree(depth, histogram_length, *tree, bw)
+	}
+	huffmanTreePool.Put(tree)
+}
+
 func sortHuffmanTree1(v0 huffmanTree, v1 huffmanTree) bool {
 	return v0.total_count_ < v1.total_count_
 }
@@ -2,8 +2,11 @@ package brotli
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"testing"
 
 	"github.com/andybalholm/brotli"
@@ -402,3 +405,425 @@ func BenchmarkWriterLevels(b *testing.B) {
 		})
 	}
 }
+
+func TestFlush(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := NewWriter(b, 5)
+	w.Write(data[:100000])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	partial := make([]byte, 100000)
+	if _, err := io.ReadFull(brotli.NewReader(bytes.NewReader(b.Bytes())), partial); err != nil {
+		t.Fatalf("error decompressing flushed data: %v", err)
+	}
+	if !bytes.Equal(partial, data[:100000]) {
+		t.Fatal("flushed output doesn't match")
+	}
+
+	// Flushing twice in a row shouldn't cause any problems.
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	w.Write(data[100000:])
+	w.Close()
+	decompressed, err := ioutil.ReadAll(brotli.NewReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, level := range []int{0, 1, 4, 6, 9, 10, 11} {
+		b := new(bytes.Buffer)
+		w := brotli.NewWriterLevel(b, level)
+		w.Write(data[:100000])
+		w.Flush()
+		w.Write(data[100000:])
+		w.Close()
+
+		d := NewDecoder(bytes.NewReader(b.Bytes()))
+		var decompressed []byte
+		var matches []pack.Match
+		var reencoded []byte
+		e := new(Encoder)
+		for {
+			start := len(decompressed)
+			decompressed, matches, err = d.NextBlock(decompressed, matches[:0])
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("error decompressing level %d: %v", level, err)
+			}
+			// Re-encode the block, using the matches from the decoder.
+			reencoded = e.Encode(reencoded, decompressed[start:], matches, false)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed output doesn't match on level %d", level)
+		}
+
+		reencoded = e.Encode(reencoded, nil, nil, true)
+		decompressed, err = ioutil.ReadAll(brotli.NewReader(bytes.NewReader(reencoded)))
+		if err != nil {
+			t.Fatalf("error decompressing re-encoded level %d: %v", level, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("re-encoded output doesn't match on level %d", level)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		b := new(bytes.Buffer)
+		w := NewWriter(b, i)
+		w.Write(data[:100000])
+		w.Flush()
+		w.Write(data[100000:])
+		w.Close()
+
+		decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(b.Bytes())))
+		if err != nil {
+			t.Fatalf("error decompressing level %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed output doesn't match on level %d", i)
+		}
+	}
+
+	// Small inputs, with a short window and the static dictionary.
+	for _, s := range []string{"", "a", "HelloHelloHelloHelloHelloHello, world", "The quick brown fox jumps over the lazy dog."} {
+		b := new(bytes.Buffer)
+		w := brotli.NewWriterOptions(b, brotli.WriterOptions{Quality: 11, LGWin: 10})
+		w.Write([]byte(s))
+		w.Close()
+		decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(b.Bytes())))
+		if err != nil {
+			t.Fatalf("error decompressing %q: %v", s, err)
+		}
+		if string(decompressed) != s {
+			t.Fatalf("got %q, want %q", decompressed, s)
+		}
+	}
+}
+
+func TestDictionarySize(t *testing.T) {
+	if len(dictionaryData) != 122784 {
+		t.Fatalf("len(dictionaryData) = %d, want 122784", len(dictionaryData))
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	w := brotli.NewWriterLevel(buf, 6)
+	w.Write(opticks)
+	w.Close()
+	compressed := buf.Bytes()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(opticks)))
+	r := NewReader(bytes.NewReader(compressed))
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
+
+func TestContextModeling(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With block splitting and literal context modeling, the output should
+	// be no larger than the reference implementation's at the same level.
+	for level := 5; level <= 9; level++ {
+		b := new(bytes.Buffer)
+		w := NewWriter(b, level)
+		w.Write(data)
+		w.Close()
+		compressed := b.Bytes()
+
+		decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+		if err != nil {
+			t.Fatalf("error decompressing level %d: %v", level, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed output doesn't match on level %d", level)
+		}
+
+		ref := new(bytes.Buffer)
+		rw := brotli.NewWriterLevel(ref, level)
+		rw.Write(data)
+		rw.Close()
+		if len(compressed) > ref.Len() {
+			t.Errorf("level %d: compressed size is %d; the reference implementation's is %d", level, len(compressed), ref.Len())
+		}
+	}
+}
+
+// smallHTML is the sort of short HTML response that the static dictionary
+// is designed for.
+const smallHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Search Results - Products and Services</title>
+<link rel="stylesheet" type="text/css" href="/static/style.css">
+<script type="text/javascript" src="/static/jquery.min.js"></script>
+</head>
+<body class="home page">
+<div id="header"><a href="/">Home</a> | <a href="/about">About Us</a> | <a href="/contact">Contact Information</a></div>
+<form method="post" action="/search"><input type="text" name="query" value="" placeholder="Search this website"><input type="submit" value="Submit"></form>
+<p>Welcome to our online community. Please read the privacy policy and terms of service before you register for an account.</p>
+<script type="text/javascript">
+function initialize() {
+	var element = document.getElementById("content");
+	if (element !== null && typeof element.addEventListener === "function") {
+		element.addEventListener("click", function(event) { return false; });
+	}
+}
+window.onload = initialize;
+</script>
+<div id="footer">Copyright &copy; All rights reserved.</div>
+</body>
+</html>
+`
+
+func TestStaticDictionary(t *testing.T) {
+	data := []byte(smallHTML)
+	compress := func(static bool) []byte {
+		b := new(bytes.Buffer)
+		w := &pack.Writer{
+			Dest: b,
+			MatchFinder: &MatchFinder{
+				Hasher:           &H5{BlockBits: 4, BucketBits: 15},
+				MaxHistory:       1 << 20,
+				MinHistory:       1 << 16,
+				StaticDictionary: static,
+			},
+			Encoder:   &Encoder{},
+			BlockSize: 1 << 16,
+		}
+		w.Write(data)
+		w.Close()
+		return b.Bytes()
+	}
+
+	without := compress(false)
+	with := compress(true)
+	for _, r := range []io.Reader{
+		brotli.NewReader(bytes.NewReader(with)),
+		NewReader(bytes.NewReader(with)),
+	} {
+		decompressed, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatal("decompressed output doesn't match")
+		}
+	}
+	t.Logf("%d bytes without the static dictionary, %d with it", len(without), len(with))
+	if len(with) >= len(without) {
+		t.Errorf("using the static dictionary didn't help: %d bytes without it, %d with it", len(without), len(with))
+	}
+}
+
+func TestLargeWindow(t *testing.T) {
+	// The random data is repeated too far back for a standard window.
+	random := make([]byte, 1<<16)
+	rand.New(rand.NewSource(1)).Read(random)
+	data := append(random, make([]byte, 17<<20)...)
+	data = append(data, random...)
+
+	if got := WindowBits(1<<25 + 1<<20); got != 26 {
+		t.Fatalf("WindowBits(1<<25 + 1<<20) = %d, want 26", got)
+	}
+
+	for _, wbits := range []int{24, 26} {
+		e := &Encoder{WindowBits: wbits}
+		b := new(bytes.Buffer)
+		w := &pack.Writer{
+			Dest: b,
+			MatchFinder: &MatchFinder{
+				Hasher:      &H4{},
+				MaxDistance: e.Limits().MaxDistance,
+				MaxHistory:  1 << 25,
+				MinHistory:  1 << 24,
+			},
+			Encoder:   e,
+			BlockSize: 1 << 20,
+		}
+		w.Write(data)
+		w.Close()
+		compressed := b.Bytes()
+
+		decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+		if err != nil {
+			t.Fatalf("WindowBits %d: %v", wbits, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("WindowBits %d: decompressed output doesn't match", wbits)
+		}
+		tooFar := len(compressed) > len(random)*3/2
+		if wbits > 24 && tooFar {
+			t.Errorf("WindowBits %d: compressed size is %d; the repeated data should have been matched", wbits, len(compressed))
+		}
+		if wbits <= 24 {
+			if !tooFar {
+				t.Errorf("WindowBits %d: compressed size is %d; the repeated data is beyond the window", wbits, len(compressed))
+			}
+			// A standard window should be readable by any decoder.
+			decompressed, err := ioutil.ReadAll(brotli.NewReader(bytes.NewReader(compressed)))
+			if err != nil || !bytes.Equal(decompressed, data) {
+				t.Fatalf("WindowBits %d: reference decoder failed: %v", wbits, err)
+			}
+		}
+	}
+}
+
+func TestLargeWindowPostfix(t *testing.T) {
+	// A hand-built large-window stream with NPOSTFIX=3, which makes the
+	// distance alphabet larger than the command alphabet.
+	var bw bitWriter
+	bw.writeBits(14, 25<<8|0x11) // large window, WBITS=25
+	bw.writeBits(1, 1)           // ISLAST
+	bw.writeBits(1, 0)           // ISLASTEMPTY
+	bw.writeBits(2, 0)           // MNIBBLES=4
+	bw.writeBits(16, 9-1)        // MLEN-1
+	bw.writeBits(3, 0)           // one block type each for literals, commands, and distances
+	bw.writeBits(2, 3)           // NPOSTFIX
+	bw.writeBits(4, 0)           // NDIRECT >> NPOSTFIX
+	bw.writeBits(2, 0)           // context mode
+	bw.writeBits(2, 0)           // one literal tree and one distance tree
+
+	// Simple prefix codes: literal 'a' only; command 156 only (3 inserted
+	// bytes, copy length 6, explicit distance); distance codes 18 and 1000.
+	bw.writeBits(4, 0<<2|1)
+	bw.writeBits(8, 'a')
+	bw.writeBits(4, 0<<2|1)
+	bw.writeBits(10, 156)
+	bw.writeBits(4, 1<<2|1)
+	bw.writeBits(10, 18)
+	bw.writeBits(10, 1000)
+
+	// The command: three literals (zero bits each), then distance code 18
+	// (dcode 2: postfix 2, one extra bit of 0), for a distance of 3.
+	bw.writeBits(1, 0)
+	bw.writeBits(1, 0)
+	bw.jumpToByteBoundary()
+
+	decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(bw.dst)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "aaaaaaaaa"; string(decompressed) != want {
+		t.Fatalf("got %q, want %q", decompressed, want)
+	}
+}
+
+func TestDistanceParams(t *testing.T) {
+	// Fixed-size records favor distances that are multiples of the record
+	// size, which NPOSTFIX can take advantage of.
+	var data []byte
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 40000; i++ {
+		var rec [16]byte
+		binary.LittleEndian.PutUint32(rec[:], uint32(i*3))
+		binary.LittleEndian.PutUint32(rec[4:], uint32(r.Intn(50)))
+		binary.LittleEndian.PutUint32(rec[8:], uint32(r.Intn(4))*1000)
+		binary.LittleEndian.PutUint32(rec[12:], 0xdeadbeef)
+		data = append(data, rec[:]...)
+	}
+
+	b := new(bytes.Buffer)
+	w := NewWriter(b, 9)
+	w.Write(data)
+	w.Close()
+	compressed := b.Bytes()
+	if e := w.Encoder.(*Encoder); e.npostfix == 0 {
+		t.Errorf("NPOSTFIX is 0 for 16-byte records")
+	}
+
+	for _, r := range []io.Reader{
+		brotli.NewReader(bytes.NewReader(compressed)),
+		NewReader(bytes.NewReader(compressed)),
+	} {
+		decompressed, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatal("decompressed output doesn't match")
+		}
+	}
+}
+
+func TestQuality(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A short input exercises the simple prefix codes for tiny alphabets.
+	inputs := [][]byte{data, []byte(smallHTML), []byte("abababab")}
+
+	for n, in := range inputs {
+		var sizes [2]int
+		for i, quality := range []int{0, 9} {
+			b := new(bytes.Buffer)
+			w := &pack.Writer{
+				Dest:        b,
+				MatchFinder: &MatchFinder{Hasher: &H5{BlockBits: 4, BucketBits: 15}, MaxHistory: 1 << 20, MinHistory: 1 << 16},
+				Encoder:     &Encoder{Quality: quality},
+				BlockSize:   1 << 16,
+			}
+			w.Write(in)
+			w.Close()
+			compressed := b.Bytes()
+			sizes[i] = len(compressed)
+
+			for _, r := range []io.Reader{
+				brotli.NewReader(bytes.NewReader(compressed)),
+				NewReader(bytes.NewReader(compressed)),
+			} {
+				decompressed, err := ioutil.ReadAll(r)
+				if err != nil {
+					t.Fatalf("quality %d: %v", quality, err)
+				}
+				if !bytes.Equal(decompressed, in) {
+					t.Fatalf("quality %d: decompressed output doesn't match", quality)
+				}
+			}
+		}
+		if n == 0 && sizes[1] >= sizes[0] {
+			t.Errorf("compressed size with quality 9 is %d; with quality 0 it is %d", sizes[1], sizes[0])
+		}
+		t.Logf("%d bytes: quality 0: %d, quality 9: %d", len(in), sizes[0], sizes[1])
+	}
+}
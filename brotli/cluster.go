@@ -0,0 +1,98 @@
+package brotli
+
+import "sort"
+
+// clusterHistograms groups histograms with similar statistics, so that they
+// can share a prefix code. It returns the merged histograms, and the index
+// of the cluster that each input histogram was assigned to. Clusters are
+// numbered in order of first use. Empty histograms are assigned to cluster
+// 0, since it doesn't matter which code they use.
+//
+// The histograms are assigned greedily, largest first: each one joins the
+// cluster where it adds the least to the estimated cost, or starts a new
+// cluster if that would be cheaper (and there are fewer than maxClusters).
+// Then each histogram is moved to the cluster that suits it best, now that
+// the clusters are complete.
+func clusterHistograms(in []histogram, maxClusters int) (clusters []histogram, symbols []int) {
+	alphabetSize := len(in[0].counts)
+	symbols = make([]int, len(in))
+	var order []int
+	for i := range in {
+		symbols[i] = -1
+		if in[i].total > 0 {
+			order = append(order, i)
+		}
+	}
+	if len(order) == 0 {
+		for i := range symbols {
+			symbols[i] = 0
+		}
+		return []histogram{newHistogram(alphabetSize)}, symbols
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return in[order[a]].total > in[order[b]].total
+	})
+
+	for _, i := range order {
+		cost := populationCost(&in[i])
+		best := -1
+		var bestDiff float64
+		for c := range clusters {
+			diff := combinedCost(&clusters[c], &in[i]) - clusters[c].cost - cost
+			if diff < bestDiff || best < 0 && len(clusters) == maxClusters {
+				best, bestDiff = c, diff
+			}
+		}
+		if best < 0 {
+			h := newHistogram(alphabetSize)
+			h.set(&in[i])
+			h.cost = cost
+			symbols[i] = len(clusters)
+			clusters = append(clusters, h)
+			continue
+		}
+		clusters[best].addHistogram(&in[i])
+		clusters[best].cost += cost + bestDiff
+		symbols[i] = best
+	}
+
+	// Move each histogram to the cluster where it adds the least cost. For
+	// its own cluster, that is the cost saved by taking it out.
+	tmp := newHistogram(alphabetSize)
+	for _, i := range order {
+		own := symbols[i]
+		tmp.set(&clusters[own])
+		tmp.subtractHistogram(&in[i])
+		bestDiff := clusters[own].cost - populationCost(&tmp)
+		for c := range clusters {
+			if c == own {
+				continue
+			}
+			if diff := combinedCost(&clusters[c], &in[i]) - clusters[c].cost; diff < bestDiff {
+				bestDiff = diff
+				symbols[i] = c
+			}
+		}
+	}
+
+	// Rebuild the clusters from their new members, numbering them in
+	// order of first use.
+	index := make([]int, len(clusters))
+	for i := range index {
+		index[i] = -1
+	}
+	var out []histogram
+	for i, s := range symbols {
+		if s < 0 {
+			symbols[i] = 0
+			continue
+		}
+		if index[s] < 0 {
+			index[s] = len(out)
+			out = append(out, newHistogram(alphabetSize))
+		}
+		symbols[i] = index[s]
+		out[index[s]].addHistogram(&in[i])
+	}
+	return out, symbols
+}
@@ -9,3 +9,44 @@ package brotli
 const numCommandSymbols = 704
 
 const numBlockLenSymbols = 26
+
+/* Specification: 3.5. Complex prefix codes */
+const repeatPreviousCodeLength = 16
+
+const repeatZeroCodeLength = 17
+
+/* "code length of 8 is repeated" */
+const initialRepeatedCodeLength = 8
+
+// maxDistanceBits is the largest number of extra bits that a distance code
+// can have, and largeMaxDistanceBits is the same limit for streams that use
+// the large-window extension.
+const (
+	maxDistanceBits      = 24
+	largeMaxDistanceBits = 62
+)
+
+// maxNPostfix is the largest value of the NPOSTFIX parameter, and
+// maxNDirectMSB is the limit on NDIRECT >> NPOSTFIX.
+const (
+	maxNPostfix   = 3
+	maxNDirectMSB = 15
+)
+
+// maxDistanceSymbols is the size of the largest distance alphabet: with
+// the large-window extension, NPOSTFIX=3, and NDIRECT=120.
+const maxDistanceSymbols = numDistanceShortCodes + maxNDirectMSB<<maxNPostfix + largeMaxDistanceBits<<(maxNPostfix+1)
+
+// The range of window sizes (as the base-2 logarithm) for standard streams
+// and for streams with the large-window extension.
+const (
+	minWindowBits      = 10
+	maxWindowBits      = 24
+	largeMaxWindowBits = 30
+)
+
+// distanceAlphabetSize returns the number of symbols in the distance
+// alphabet for the given NPOSTFIX and NDIRECT parameters.
+func distanceAlphabetSize(npostfix uint, ndirect int, maxBits int) int {
+	return numDistanceShortCodes + ndirect + maxBits<<(npostfix+1)
+}
@@ -0,0 +1,114 @@
+package brotli
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// costs holds the estimated number of bits for each literal, command, and
+// distance code.
+type costs struct {
+	literal     [256]float32
+	command     [704]float32
+	distance    [maxDistanceSymbols]float32
+	initialized bool
+}
+
+// init sets the costs to their starting values, as if each symbol were
+// equally likely.
+func (c *costs) init() {
+	for i := range c.literal {
+		c.literal[i] = 8
+	}
+	for i := range c.command {
+		c.command[i] = 9.5
+	}
+	for i := range c.distance {
+		c.distance[i] = 6
+	}
+	c.initialized = true
+}
+
+// setDepthCosts sets costs to the Huffman code lengths in depths, which
+// has one set of code lengths for each histogram in histos. When a symbol
+// is used in more than one histogram, its cost is the average length,
+// weighted by how often it occurs in each. Symbols that weren't used at all
+// are given a cost one bit more than the longest code. If the histograms
+// are empty, costs is left as it was.
+func setDepthCosts(costs []float32, histos []histogram, depths [][]byte) {
+	var longest byte
+	used := false
+	for i := range histos {
+		for sym, n := range histos[i].counts {
+			if n > 0 {
+				used = true
+				if depths[i][sym] > longest {
+					longest = depths[i][sym]
+				}
+			}
+		}
+	}
+	if !used {
+		return
+	}
+	for sym := range costs {
+		var bits float64
+		var count uint32
+		for i := range histos {
+			if n := histos[i].counts[sym]; n > 0 {
+				bits += float64(n) * float64(depths[i][sym])
+				count += n
+			}
+		}
+		if count > 0 {
+			costs[sym] = float32(bits / float64(count))
+		} else {
+			costs[sym] = float32(longest + 1)
+		}
+	}
+}
+
+// LiteralCost returns the length of the Huffman code for b in the most
+// recent meta-block.
+func (e *Encoder) LiteralCost(b byte) float32 {
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+	return e.costs.literal[b]
+}
+
+// MatchCost returns the number of bits needed to encode the command for m,
+// based on the Huffman codes from the most recent meta-block. Distances that
+// are equal or close to recent ones use a short distance code if it is
+// cheaper than coding them explicitly. For static
+// dictionary references, it assumes the maximum backward distance is the
+// whole window.
+func (e *Encoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	dictionary := isStaticDictionaryMatch(m.Distance)
+	if m.Length < 2 || m.Distance < 1 || m.Distance > e.Limits().MaxDistance && !dictionary {
+		return float32(math.Inf(1))
+	}
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+
+	insertCode := getInsertLengthCode(uint(m.Unmatched))
+	copyCode := getCopyLengthCode(uint(copyLength(m)))
+	command := combineLengthCodes(insertCode, copyCode, false)
+	cost := e.costs.command[command] + float32(kInsExtra[insertCode]+kCopyExtra[copyCode])
+
+	if dictionary {
+		_, wordID := staticDictionaryReference(m.Distance)
+		distCode := getDistanceCode(e.Limits().MaxDistance+1+wordID, e.npostfix, e.ndirect)
+		return cost + e.costs.distance[distCode.code] + float32(distCode.nExtra)
+	}
+
+	// This follows the same logic as Encode.
+	distCode := getDistanceCode(m.Distance, e.npostfix, e.ndirect)
+	distCost := e.costs.distance[distCode.code] + float32(distCode.nExtra)
+	if code, c := e.shortDistanceCode(m.Distance, recent); code >= 0 && c < distCost {
+		distCost = c
+	}
+	return cost + distCost
+}
@@ -0,0 +1,1266 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bufio"
+	"io"
+	"math/bits"
+	"strconv"
+	"sync"
+
+	"github.com/andybalholm/pack"
+)
+
+// The Huffman decoding in this file is based on compress/flate's inflate.go.
+// The rest of the decoder follows RFC 7932.
+
+const (
+	maxCodeLen = 16 // max length of Huffman code, plus one
+
+	numLiteralSymbols     = 256
+	numDistanceShortCodes = 16
+	codeLengthCodes       = 18
+)
+
+// A CorruptInputError reports the presence of corrupt input at a given offset.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "brotli: corrupt input before offset " + strconv.FormatInt(int64(e), 10)
+}
+
+// The data structure for decoding Huffman tables is based on that of
+// zlib. There is a lookup table of a fixed bit width (huffmanChunkBits),
+// For codes smaller than the table width, there are multiple entries
+// (each combination of trailing bits has the same value). For codes
+// larger than the table width, the table contains a link to an overflow
+// table. The width of each entry in the link table is the maximum code
+// size minus the chunk width.
+//
+// Note that you can do a lookup in the table even without all bits
+// filled. Since the extra bits are zero, and the Brotli prefix codes
+// have the property that shorter codes come before longer ones, the
+// bit length estimate in the result is a lower bound on the actual
+// number of bits.
+//
+// See the following:
+//	https://github.com/madler/zlib/raw/master/doc/algorithm.txt
+
+// chunk & 15 is number of bits
+// chunk >> 4 is value, including table link
+
+const (
+	huffmanChunkBits  = 9
+	huffmanNumChunks  = 1 << huffmanChunkBits
+	huffmanCountMask  = 15
+	huffmanValueShift = 4
+)
+
+type huffmanDecoder struct {
+	min      int                      // the minimum code length
+	chunks   [huffmanNumChunks]uint32 // chunks as described above
+	links    [][]uint32               // overflow links
+	linkMask uint32                   // mask the width of the link table
+
+	// A code with only one symbol uses zero bits.
+	singleSymbol bool
+	symbol       int
+}
+
+// Initialize Huffman decoding tables from array of code lengths.
+// It returns false unless the code is complete (i.e., neither
+// over-subscribed nor under-subscribed).
+func (h *huffmanDecoder) init(lengths []int) bool {
+	h.links = nil
+	h.linkMask = 0
+	h.singleSymbol = false
+
+	// Count number of codes of each length,
+	// compute min and max length.
+	var count [maxCodeLen]int
+	var min, max int
+	for _, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		if min == 0 || n < min {
+			min = n
+		}
+		if n > max {
+			max = n
+		}
+		count[n]++
+	}
+
+	if max == 0 {
+		return false
+	}
+
+	code := 0
+	var nextcode [maxCodeLen]int
+	for i := min; i <= max; i++ {
+		code <<= 1
+		nextcode[i] = code
+		code += count[i]
+	}
+
+	// Check that the coding is complete (i.e., that we've
+	// assigned all 2-to-the-max possible bit sequences).
+	if code != 1<<uint(max) {
+		return false
+	}
+
+	h.min = min
+	if max > huffmanChunkBits {
+		numLinks := 1 << (uint(max) - huffmanChunkBits)
+		h.linkMask = uint32(numLinks - 1)
+
+		// create link tables
+		link := nextcode[huffmanChunkBits+1] >> 1
+		h.links = make([][]uint32, huffmanNumChunks-link)
+		for j := uint(link); j < huffmanNumChunks; j++ {
+			reverse := int(bits.Reverse16(uint16(j)))
+			reverse >>= uint(16 - huffmanChunkBits)
+			off := j - uint(link)
+			h.chunks[reverse] = uint32(off<<huffmanValueShift | (huffmanChunkBits + 1))
+			h.links[off] = make([]uint32, numLinks)
+		}
+	}
+
+	for i, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		code := nextcode[n]
+		nextcode[n]++
+		chunk := uint32(i<<huffmanValueShift | n)
+		reverse := int(bits.Reverse16(uint16(code)))
+		reverse >>= uint(16 - n)
+		if n <= huffmanChunkBits {
+			for off := reverse; off < len(h.chunks); off += 1 << uint(n) {
+				h.chunks[off] = chunk
+			}
+		} else {
+			j := reverse & (huffmanNumChunks - 1)
+			value := h.chunks[j] >> huffmanValueShift
+			linktab := h.links[value]
+			reverse >>= huffmanChunkBits
+			for off := reverse; off < len(linktab); off += 1 << uint(n-huffmanChunkBits) {
+				linktab[off] = chunk
+			}
+		}
+	}
+
+	return true
+}
+
+// initSingle initializes h to a zero-bit code that always returns symbol.
+func (h *huffmanDecoder) initSingle(symbol int) {
+	h.links = nil
+	h.linkMask = 0
+	h.min = 0
+	h.singleSymbol = true
+	h.symbol = symbol
+}
+
+// codeLengthCodeOrder is the order in which the code lengths of the code
+// length code are stored.
+var codeLengthCodeOrder = [codeLengthCodes]int{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// The code lengths of the code length code are stored with a fixed prefix code.
+var codeLengthCodeOnce sync.Once
+var codeLengthCodeDecoder huffmanDecoder
+
+func codeLengthCodeDecoderInit() {
+	codeLengthCodeOnce.Do(func() {
+		codeLengthCodeDecoder.init([]int{2, 4, 3, 2, 2, 4})
+	})
+}
+
+// insertBase and copyBase give the first insert and copy length codes for
+// each range of 64 insert-and-copy length symbols.
+var insertBase = [11]int{0, 0, 0, 0, 8, 8, 0, 16, 8, 16, 16}
+var copyBase = [11]int{0, 8, 0, 8, 0, 8, 16, 0, 16, 8, 16}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// A blockSwitch holds the state of the block types and counts for one
+// category (literals, insert-and-copy lengths, or distances).
+type blockSwitch struct {
+	numTypes  int
+	typeCode  huffmanDecoder
+	countCode huffmanDecoder
+
+	current   int // the current block type
+	previous  int // the previous block type
+	remaining int // the number of symbols left in the current block
+}
+
+// A Decoder reads a Brotli stream one meta-block at a time. Along with the
+// decompressed data, it returns the commands that the data was encoded with,
+// in the same form that a pack.MatchFinder produces, so that the data can be
+// analyzed or re-encoded with a different Encoder.
+//
+// References to the static dictionary are reported as unmatched bytes,
+// since they don't refer to earlier data.
+//
+// Streams that use the large-window extension (window sizes up to 1 GB) are
+// accepted as well as standard ones.
+type Decoder struct {
+	r       byteReader
+	rBuf    *bufio.Reader
+	roffset int64
+
+	// Input bits, in top of b.
+	b  uint32
+	nb uint
+
+	// history holds the decompressed data from previous meta-blocks that may
+	// be referenced by matches, followed by the current meta-block.
+	history     []byte
+	windowSize  int
+	largeWindow bool
+	pos         int64 // total bytes decompressed before the current meta-block
+
+	// dictionary is a raw shared dictionary, which is treated as if it
+	// had been decompressed before the start of the stream.
+	dictionary []byte
+
+	// dist holds the last four distances, most recent first.
+	dist [4]int
+
+	blocks             [3]blockSwitch // literals, commands, distances
+	contextModes       []byte
+	literalContextMap  []byte
+	distanceContextMap []byte
+	literalCodes       []huffmanDecoder
+	commandCodes       []huffmanDecoder
+	distanceCodes      []huffmanDecoder
+	contextMapCode     huffmanDecoder
+	lengths            [maxDistanceSymbols]int // big enough for the largest alphabet
+
+	startStream bool
+	final       bool
+	err         error
+}
+
+// NewDecoder returns a Decoder that reads Brotli data from r.
+// If r does not also implement io.ByteReader, the Decoder may read more data
+// than necessary from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.Reset(r)
+	return d
+}
+
+// Reset discards the Decoder's state and prepares it to read a new stream
+// from r.
+func (d *Decoder) Reset(r io.Reader) {
+	codeLengthCodeDecoderInit()
+
+	if rr, ok := r.(byteReader); ok {
+		d.r = rr
+	} else {
+		if d.rBuf == nil {
+			d.rBuf = bufio.NewReader(r)
+		} else {
+			d.rBuf.Reset(r)
+		}
+		d.r = d.rBuf
+	}
+
+	d.roffset = 0
+	d.b, d.nb = 0, 0
+	d.history = append(d.history[:0], d.dictionary...)
+	d.pos = int64(len(d.dictionary))
+	d.dist = [4]int{4, 11, 15, 16}
+	d.startStream = true
+	d.final = false
+	d.err = nil
+}
+
+// SetDictionary sets a raw shared dictionary (also known as a prefix
+// dictionary) that the stream was compressed with. Brotli streams don't
+// identify their dictionary, so the caller needs to know which one to use.
+// SetDictionary should be called before the first call to NextBlock.
+func (d *Decoder) SetDictionary(dict []byte) {
+	d.dictionary = append(d.dictionary[:0], dict...)
+	if d.startStream && d.roffset == 0 {
+		d.history = append(d.history[:0], d.dictionary...)
+		d.pos = int64(len(d.dictionary))
+	}
+}
+
+// NextBlock decodes the next meta-block. It appends the decompressed data
+// to dst and the meta-block's commands to matches, and returns the updated
+// slices. The matches cover exactly the data appended to dst, but they may
+// refer back to data from previous meta-blocks. After the last meta-block,
+// NextBlock returns io.EOF.
+func (d *Decoder) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if d.err != nil {
+		return dst, matches, d.err
+	}
+
+	if d.final {
+		d.err = io.EOF
+		return dst, matches, d.err
+	}
+
+	if d.startStream {
+		if d.err = d.readStreamHeader(); d.err != nil {
+			return dst, matches, d.err
+		}
+		d.startStream = false
+	}
+
+	if len(d.history) > 2*d.windowSize {
+		n := copy(d.history, d.history[len(d.history)-d.windowSize:])
+		d.history = d.history[:n]
+	}
+	start := len(d.history)
+
+	matches, d.err = d.nextBlock(matches)
+	if d.err != nil {
+		d.err = noEOF(d.err)
+		return dst, matches, d.err
+	}
+
+	block := d.history[start:]
+	d.pos += int64(len(block))
+	return append(dst, block...), matches, nil
+}
+
+// readStreamHeader reads the window size.
+func (d *Decoder) readStreamHeader() error {
+	wbits := 16
+	d.largeWindow = false
+	n, err := d.readBits(1)
+	if err != nil {
+		return err
+	}
+	if n != 0 {
+		n, err = d.readBits(3)
+		if err != nil {
+			return err
+		}
+		if n != 0 {
+			wbits = 17 + int(n)
+		} else {
+			n, err = d.readBits(3)
+			if err != nil {
+				return err
+			}
+			switch n {
+			case 0:
+				wbits = 17
+			case 1:
+				// The large-window extension: a reserved bit, then the
+				// window size in 6 bits.
+				n, err = d.readBits(7)
+				if err != nil {
+					return err
+				}
+				wbits = int(n >> 1)
+				if n&1 != 0 || wbits < minWindowBits || wbits > largeMaxWindowBits {
+					return CorruptInputError(d.roffset)
+				}
+				d.largeWindow = true
+			default:
+				wbits = 8 + int(n)
+			}
+		}
+	}
+	d.windowSize = 1<<uint(wbits) - 16
+	return nil
+}
+
+func (d *Decoder) nextBlock(matches []pack.Match) ([]pack.Match, error) {
+	isLast, err := d.readBits(1)
+	if err != nil {
+		return matches, err
+	}
+	if isLast == 1 {
+		d.final = true
+		isEmpty, err := d.readBits(1)
+		if err != nil {
+			return matches, err
+		}
+		if isEmpty == 1 {
+			return matches, d.skipPadding()
+		}
+	}
+
+	mnibbles, err := d.readBits(2)
+	if err != nil {
+		return matches, err
+	}
+	if mnibbles == 3 {
+		if d.final {
+			return matches, CorruptInputError(d.roffset)
+		}
+		return matches, d.metadataBlock()
+	}
+
+	nibbles := int(mnibbles) + 4
+	mlen := 0
+	for i := 0; i < nibbles; i++ {
+		v, err := d.readBits(4)
+		if err != nil {
+			return matches, err
+		}
+		if i == nibbles-1 && nibbles > 4 && v == 0 {
+			return matches, CorruptInputError(d.roffset)
+		}
+		mlen |= int(v) << uint(4*i)
+	}
+	mlen++
+
+	if !d.final {
+		uncompressed, err := d.readBits(1)
+		if err != nil {
+			return matches, err
+		}
+		if uncompressed == 1 {
+			return d.uncompressedBlock(matches, mlen)
+		}
+	}
+
+	matches, err = d.compressedBlock(matches, mlen)
+	if err != nil {
+		return matches, err
+	}
+	if d.final {
+		err = d.skipPadding()
+	}
+	return matches, err
+}
+
+// metadataBlock skips over the contents of a metadata meta-block.
+func (d *Decoder) metadataBlock() error {
+	reserved, err := d.readBits(1)
+	if err != nil {
+		return err
+	}
+	if reserved != 0 {
+		return CorruptInputError(d.roffset)
+	}
+	skipBytes, err := d.readBits(2)
+	if err != nil {
+		return err
+	}
+	skipLen := 0
+	for i := 0; i < int(skipBytes); i++ {
+		v, err := d.readBits(8)
+		if err != nil {
+			return err
+		}
+		if i == int(skipBytes)-1 && skipBytes > 1 && v == 0 {
+			return CorruptInputError(d.roffset)
+		}
+		skipLen |= int(v) << uint(8*i)
+	}
+	if skipBytes > 0 {
+		skipLen++
+	}
+	if err := d.skipPadding(); err != nil {
+		return err
+	}
+	for i := 0; i < skipLen; i++ {
+		if _, err := d.readByte(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uncompressedBlock reads an uncompressed meta-block of length n.
+func (d *Decoder) uncompressedBlock(matches []pack.Match, n int) ([]pack.Match, error) {
+	if err := d.skipPadding(); err != nil {
+		return matches, err
+	}
+	start := len(d.history)
+	for cap(d.history) < start+n {
+		d.history = append(d.history[:cap(d.history)], 0)
+	}
+	d.history = d.history[:start+n]
+	if err := d.readFull(d.history[start:]); err != nil {
+		d.history = d.history[:start]
+		return matches, err
+	}
+	return append(matches, pack.Match{Unmatched: n}), nil
+}
+
+// compressedBlock decodes a compressed meta-block of length mlen, appending
+// the data to d.history and the commands to matches.
+func (d *Decoder) compressedBlock(matches []pack.Match, mlen int) ([]pack.Match, error) {
+	for i := range d.blocks {
+		if err := d.readBlockSwitch(&d.blocks[i]); err != nil {
+			return matches, err
+		}
+	}
+
+	npostfix, err := d.readBits(2)
+	if err != nil {
+		return matches, err
+	}
+	ndirect, err := d.readBits(4)
+	if err != nil {
+		return matches, err
+	}
+	ndirect <<= npostfix
+
+	numLiteralTypes := d.blocks[0].numTypes
+	d.contextModes = d.contextModes[:0]
+	for i := 0; i < numLiteralTypes; i++ {
+		mode, err := d.readBits(2)
+		if err != nil {
+			return matches, err
+		}
+		d.contextModes = append(d.contextModes, byte(mode))
+	}
+
+	var numLiteralTrees, numDistanceTrees int
+	numLiteralTrees, d.literalContextMap, err = d.readContextMap(d.literalContextMap, numLiteralTypes<<6)
+	if err != nil {
+		return matches, err
+	}
+	numDistanceTrees, d.distanceContextMap, err = d.readContextMap(d.distanceContextMap, d.blocks[2].numTypes<<2)
+	if err != nil {
+		return matches, err
+	}
+
+	d.literalCodes, err = d.readPrefixCodes(d.literalCodes, numLiteralTrees, numLiteralSymbols)
+	if err != nil {
+		return matches, err
+	}
+	d.commandCodes, err = d.readPrefixCodes(d.commandCodes, d.blocks[1].numTypes, numCommandSymbols)
+	if err != nil {
+		return matches, err
+	}
+	maxBits := maxDistanceBits
+	if d.largeWindow {
+		maxBits = largeMaxDistanceBits
+	}
+	numDistanceSymbols := distanceAlphabetSize(uint(npostfix), int(ndirect), maxBits)
+	d.distanceCodes, err = d.readPrefixCodes(d.distanceCodes, numDistanceTrees, numDistanceSymbols)
+	if err != nil {
+		return matches, err
+	}
+
+	literalBlocks := &d.blocks[0]
+	commandBlocks := &d.blocks[1]
+	distanceBlocks := &d.blocks[2]
+	contextLUT := getContextLUT(int(d.contextModes[0]))
+	start := len(d.history)
+	end := start + mlen
+	unmatched := 0
+
+	for len(d.history) < end {
+		if commandBlocks.remaining == 0 {
+			if err := d.switchBlock(commandBlocks); err != nil {
+				return matches, err
+			}
+		}
+		commandBlocks.remaining--
+
+		cmd, err := d.huffSym(&d.commandCodes[commandBlocks.current])
+		if err != nil {
+			return matches, err
+		}
+		insertCode := insertBase[cmd>>6] + (cmd>>3)&7
+		copyCode := copyBase[cmd>>6] + cmd&7
+		extra, err := d.readBits(uint(kInsExtra[insertCode]))
+		if err != nil {
+			return matches, err
+		}
+		insertLen := int(kInsBase[insertCode]) + int(extra)
+		extra, err = d.readBits(uint(kCopyExtra[copyCode]))
+		if err != nil {
+			return matches, err
+		}
+		copyLen := int(kCopyBase[copyCode]) + int(extra)
+
+		if len(d.history)+insertLen > end {
+			return matches, CorruptInputError(d.roffset)
+		}
+		for i := 0; i < insertLen; i++ {
+			if literalBlocks.remaining == 0 {
+				if err := d.switchBlock(literalBlocks); err != nil {
+					return matches, err
+				}
+				contextLUT = getContextLUT(int(d.contextModes[literalBlocks.current]))
+			}
+			literalBlocks.remaining--
+
+			var p1, p2 byte
+			if n := len(d.history); n > 1 {
+				p1, p2 = d.history[n-1], d.history[n-2]
+			} else if n == 1 {
+				p1 = d.history[0]
+			}
+			context := getContext(p1, p2, contextLUT)
+			tree := d.literalContextMap[literalBlocks.current<<6+int(context)]
+			lit, err := d.huffSym(&d.literalCodes[tree])
+			if err != nil {
+				return matches, err
+			}
+			d.history = append(d.history, byte(lit))
+		}
+		unmatched += insertLen
+
+		if len(d.history) == end {
+			// The meta-block ends after the insert, so the copy is ignored.
+			break
+		}
+
+		var distance int
+		var distCode int
+		if cmd < 128 {
+			// implicit distance code 0
+			distance = d.dist[0]
+		} else {
+			if distanceBlocks.remaining == 0 {
+				if err := d.switchBlock(distanceBlocks); err != nil {
+					return matches, err
+				}
+			}
+			distanceBlocks.remaining--
+
+			context := copyLen - 2
+			if copyLen > 4 {
+				context = 3
+			}
+			tree := d.distanceContextMap[distanceBlocks.current<<2+context]
+			distCode, err = d.huffSym(&d.distanceCodes[tree])
+			if err != nil {
+				return matches, err
+			}
+			distance, err = d.distance(distCode, uint(npostfix), int(ndirect))
+			if err != nil {
+				return matches, err
+			}
+		}
+
+		maxDistance := d.windowSize
+		if pos := d.pos + int64(len(d.history)-start); pos < int64(maxDistance) {
+			maxDistance = int(pos)
+		}
+
+		if distance > maxDistance {
+			// static dictionary reference
+			if copyLen < minDictionaryWordLength || copyLen > maxDictionaryWordLength {
+				return matches, CorruptInputError(d.roffset)
+			}
+			wordID := distance - maxDistance - 1
+			nbits := uint(dictionarySizeBitsByLength[copyLen])
+			transformIdx := wordID >> nbits
+			if transformIdx >= numTransforms {
+				return matches, CorruptInputError(d.roffset)
+			}
+			offset := int(dictionaryOffsetsByLength[copyLen]) + (wordID&(1<<nbits-1))*copyLen
+			word := dictionaryData[offset : offset+copyLen]
+
+			before := len(d.history)
+			d.history = transformDictionaryWord(d.history, word, transformIdx)
+			if len(d.history) > end {
+				return matches, CorruptInputError(d.roffset)
+			}
+			unmatched += len(d.history) - before
+			continue
+		}
+
+		if distCode != 0 {
+			d.dist = [4]int{distance, d.dist[0], d.dist[1], d.dist[2]}
+		}
+		if len(d.history)+copyLen > end {
+			return matches, CorruptInputError(d.roffset)
+		}
+		pos := len(d.history) - distance
+		if copyLen <= distance {
+			d.history = append(d.history, d.history[pos:pos+copyLen]...)
+		} else {
+			for i := 0; i < copyLen; i++ {
+				d.history = append(d.history, d.history[pos+i])
+			}
+		}
+
+		matches = append(matches, pack.Match{
+			Unmatched: unmatched,
+			Length:    copyLen,
+			Distance:  distance,
+		})
+		unmatched = 0
+	}
+
+	if unmatched > 0 {
+		matches = append(matches, pack.Match{Unmatched: unmatched})
+	}
+	return matches, nil
+}
+
+// distance translates a distance code into a distance, reading any extra
+// bits that are needed.
+func (d *Decoder) distance(code int, npostfix uint, ndirect int) (int, error) {
+	if code < numDistanceShortCodes {
+		var distance int
+		switch {
+		case code < 4:
+			distance = d.dist[code]
+		case code < 10:
+			distance = d.dist[0] + shortCodeOffset[code-4]
+		default:
+			distance = d.dist[1] + shortCodeOffset[code-10]
+		}
+		if distance <= 0 {
+			return 0, CorruptInputError(d.roffset)
+		}
+		return distance, nil
+	}
+
+	if code < numDistanceShortCodes+ndirect {
+		return code - numDistanceShortCodes + 1, nil
+	}
+
+	code -= numDistanceShortCodes + ndirect
+	ndistbits := 1 + uint(code>>(npostfix+1))
+	hcode := code >> npostfix
+	lcode := code & (1<<npostfix - 1)
+	offset := (2+hcode&1)<<ndistbits - 4
+	extra, err := d.readBits(ndistbits)
+	if err != nil {
+		return 0, err
+	}
+	return (offset+int(extra))<<npostfix + lcode + ndirect + 1, nil
+}
+
+// shortCodeOffset is the amount added to the last or second-to-last
+// distance by distance codes 4–9 and 10–15.
+var shortCodeOffset = [6]int{-1, 1, -2, 2, -3, 3}
+
+// readBlockSwitch reads the number of block types for one category, and the
+// prefix codes for switching between them.
+func (d *Decoder) readBlockSwitch(bs *blockSwitch) error {
+	n, err := d.readVarLenUint8()
+	if err != nil {
+		return err
+	}
+	bs.numTypes = n + 1
+	bs.current = 0
+	bs.previous = 1
+	if bs.numTypes < 2 {
+		bs.remaining = 1 << 28
+		return nil
+	}
+
+	if err := d.readPrefixCode(&bs.typeCode, bs.numTypes+2); err != nil {
+		return err
+	}
+	if err := d.readPrefixCode(&bs.countCode, numBlockLenSymbols); err != nil {
+		return err
+	}
+	bs.remaining, err = d.readBlockLength(bs)
+	return err
+}
+
+// switchBlock reads a block switch command.
+func (d *Decoder) switchBlock(bs *blockSwitch) error {
+	code, err := d.huffSym(&bs.typeCode)
+	if err != nil {
+		return err
+	}
+	var newType int
+	switch code {
+	case 0:
+		newType = bs.previous
+	case 1:
+		newType = bs.current + 1
+		if newType >= bs.numTypes {
+			newType -= bs.numTypes
+		}
+	default:
+		newType = code - 2
+	}
+	bs.previous = bs.current
+	bs.current = newType
+
+	bs.remaining, err = d.readBlockLength(bs)
+	return err
+}
+
+func (d *Decoder) readBlockLength(bs *blockSwitch) (int, error) {
+	code, err := d.huffSym(&bs.countCode)
+	if err != nil {
+		return 0, err
+	}
+	r := kBlockLengthPrefixCode[code]
+	extra, err := d.readBits(uint(r.nbits))
+	if err != nil {
+		return 0, err
+	}
+	return int(r.offset) + int(extra), nil
+}
+
+// readVarLenUint8 reads a number in the range 0–255, stored in 1–11 bits.
+func (d *Decoder) readVarLenUint8() (int, error) {
+	b, err := d.readBits(1)
+	if err != nil || b == 0 {
+		return 0, err
+	}
+	n, err := d.readBits(3)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 1, nil
+	}
+	extra, err := d.readBits(uint(n))
+	if err != nil {
+		return 0, err
+	}
+	return 1<<n + int(extra), nil
+}
+
+// readContextMap reads a context map with size entries, storing it in cmap
+// (which is reallocated if necessary). It returns the number of prefix codes
+// used.
+func (d *Decoder) readContextMap(cmap []byte, size int) (int, []byte, error) {
+	if cap(cmap) < size {
+		cmap = make([]byte, size)
+	}
+	cmap = cmap[:size]
+
+	n, err := d.readVarLenUint8()
+	if err != nil {
+		return 0, cmap, err
+	}
+	numTrees := n + 1
+	if numTrees < 2 {
+		for i := range cmap {
+			cmap[i] = 0
+		}
+		return numTrees, cmap, nil
+	}
+
+	rleMax := 0
+	useRLE, err := d.readBits(1)
+	if err != nil {
+		return 0, cmap, err
+	}
+	if useRLE == 1 {
+		v, err := d.readBits(4)
+		if err != nil {
+			return 0, cmap, err
+		}
+		rleMax = int(v) + 1
+	}
+
+	if err := d.readPrefixCode(&d.contextMapCode, numTrees+rleMax); err != nil {
+		return 0, cmap, err
+	}
+
+	for i := 0; i < size; {
+		sym, err := d.huffSym(&d.contextMapCode)
+		if err != nil {
+			return 0, cmap, err
+		}
+		switch {
+		case sym == 0:
+			cmap[i] = 0
+			i++
+		case sym <= rleMax:
+			extra, err := d.readBits(uint(sym))
+			if err != nil {
+				return 0, cmap, err
+			}
+			reps := 1<<uint(sym) + int(extra)
+			if i+reps > size {
+				return 0, cmap, CorruptInputError(d.roffset)
+			}
+			for ; reps > 0; reps-- {
+				cmap[i] = 0
+				i++
+			}
+		default:
+			cmap[i] = byte(sym - rleMax)
+			i++
+		}
+	}
+
+	imtf, err := d.readBits(1)
+	if err != nil {
+		return 0, cmap, err
+	}
+	if imtf == 1 {
+		inverseMoveToFrontTransform(cmap)
+	}
+	return numTrees, cmap, nil
+}
+
+func inverseMoveToFrontTransform(v []byte) {
+	var mtf [256]byte
+	for i := range mtf {
+		mtf[i] = byte(i)
+	}
+	for i, index := range v {
+		value := mtf[index]
+		v[i] = value
+		copy(mtf[1:index+1], mtf[:index])
+		mtf[0] = value
+	}
+}
+
+// readPrefixCodes reads n prefix codes, storing them in codes (which is
+// reallocated if necessary).
+func (d *Decoder) readPrefixCodes(codes []huffmanDecoder, n int, alphabetSize int) ([]huffmanDecoder, error) {
+	if cap(codes) < n {
+		codes = make([]huffmanDecoder, n)
+	}
+	codes = codes[:n]
+	for i := range codes {
+		if err := d.readPrefixCode(&codes[i], alphabetSize); err != nil {
+			return codes, err
+		}
+	}
+	return codes, nil
+}
+
+// readPrefixCode reads a prefix code description (RFC 7932 section 3.4 and
+// 3.5), and initializes h with it.
+func (d *Decoder) readPrefixCode(h *huffmanDecoder, alphabetSize int) error {
+	hskip, err := d.readBits(2)
+	if err != nil {
+		return err
+	}
+	if hskip == 1 {
+		return d.readSimplePrefixCode(h, alphabetSize)
+	}
+
+	// Read the code lengths of the code length code.
+	var codeLengthCodeLengths [codeLengthCodes]int
+	space := 32
+	numCodes := 0
+	for i := int(hskip); i < codeLengthCodes; i++ {
+		v, err := d.huffSym(&codeLengthCodeDecoder)
+		if err != nil {
+			return err
+		}
+		codeLengthCodeLengths[codeLengthCodeOrder[i]] = v
+		if v != 0 {
+			space -= 32 >> uint(v)
+			numCodes++
+			if space <= 0 {
+				break
+			}
+		}
+	}
+	if numCodes != 1 && space != 0 {
+		return CorruptInputError(d.roffset)
+	}
+
+	var codeLengthCode huffmanDecoder
+	if numCodes == 1 {
+		for sym, n := range codeLengthCodeLengths {
+			if n != 0 {
+				codeLengthCode.initSingle(sym)
+			}
+		}
+	} else if !codeLengthCode.init(codeLengthCodeLengths[:]) {
+		return CorruptInputError(d.roffset)
+	}
+
+	// Read the symbol code lengths.
+	lengths := d.lengths[:alphabetSize]
+	for i := range lengths {
+		lengths[i] = 0
+	}
+	prevCodeLen := 8
+	repeat := 0
+	repeatCodeLen := 0
+	space = 32768
+	for sym := 0; sym < alphabetSize && space > 0; {
+		v, err := d.huffSym(&codeLengthCode)
+		if err != nil {
+			return err
+		}
+		if v < 16 {
+			repeat = 0
+			lengths[sym] = v
+			sym++
+			if v != 0 {
+				prevCodeLen = v
+				space -= 32768 >> uint(v)
+			}
+			continue
+		}
+
+		extraBits := uint(2)
+		newLen := prevCodeLen
+		if v == 17 {
+			extraBits = 3
+			newLen = 0
+		}
+		if repeatCodeLen != newLen {
+			repeat = 0
+			repeatCodeLen = newLen
+		}
+		oldRepeat := repeat
+		if repeat > 0 {
+			repeat -= 2
+			repeat <<= extraBits
+		}
+		extra, err := d.readBits(extraBits)
+		if err != nil {
+			return err
+		}
+		repeat += int(extra) + 3
+		delta := repeat - oldRepeat
+		if sym+delta > alphabetSize {
+			return CorruptInputError(d.roffset)
+		}
+		for i := 0; i < delta; i++ {
+			lengths[sym] = repeatCodeLen
+			sym++
+		}
+		if repeatCodeLen != 0 {
+			space -= delta << uint(15-repeatCodeLen)
+		}
+	}
+	if space != 0 {
+		return CorruptInputError(d.roffset)
+	}
+
+	if !h.init(lengths) {
+		return CorruptInputError(d.roffset)
+	}
+	return nil
+}
+
+// readSimplePrefixCode reads a prefix code with 1–4 symbols.
+func (d *Decoder) readSimplePrefixCode(h *huffmanDecoder, alphabetSize int) error {
+	n, err := d.readBits(2)
+	if err != nil {
+		return err
+	}
+	numSymbols := int(n) + 1
+	alphabetBits := uint(bits.Len(uint(alphabetSize - 1)))
+
+	var symbols [4]int
+	for i := 0; i < numSymbols; i++ {
+		v, err := d.readBits(alphabetBits)
+		if err != nil {
+			return err
+		}
+		if int(v) >= alphabetSize {
+			return CorruptInputError(d.roffset)
+		}
+		for j := 0; j < i; j++ {
+			if symbols[j] == int(v) {
+				return CorruptInputError(d.roffset)
+			}
+		}
+		symbols[i] = int(v)
+	}
+
+	if numSymbols == 1 {
+		h.initSingle(symbols[0])
+		return nil
+	}
+
+	var codeLengths [4]int
+	switch numSymbols {
+	case 2:
+		codeLengths = [4]int{1, 1}
+	case 3:
+		codeLengths = [4]int{1, 2, 2}
+	case 4:
+		treeSelect, err := d.readBits(1)
+		if err != nil {
+			return err
+		}
+		if treeSelect == 0 {
+			codeLengths = [4]int{2, 2, 2, 2}
+		} else {
+			codeLengths = [4]int{1, 2, 3, 3}
+		}
+	}
+
+	lengths := d.lengths[:alphabetSize]
+	for i := range lengths {
+		lengths[i] = 0
+	}
+	for i := 0; i < numSymbols; i++ {
+		lengths[symbols[i]] = codeLengths[i]
+	}
+	if !h.init(lengths) {
+		return CorruptInputError(d.roffset)
+	}
+	return nil
+}
+
+// noEOF returns err, unless err == io.EOF, in which case it returns io.ErrUnexpectedEOF.
+func noEOF(e error) error {
+	if e == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return e
+}
+
+func (d *Decoder) moreBits() error {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return noEOF(err)
+	}
+	d.roffset++
+	d.b |= uint32(c) << d.nb
+	d.nb += 8
+	return nil
+}
+
+// readBits reads n bits (up to 24) from the input.
+func (d *Decoder) readBits(n uint) (uint32, error) {
+	for d.nb < n {
+		if err := d.moreBits(); err != nil {
+			return 0, err
+		}
+	}
+	v := d.b & (1<<n - 1)
+	d.b >>= n
+	d.nb -= n
+	return v, nil
+}
+
+// skipPadding discards the bits up to the next byte boundary, which must
+// be zero.
+func (d *Decoder) skipPadding() error {
+	n := d.nb & 7
+	if d.b&(1<<n-1) != 0 {
+		return CorruptInputError(d.roffset)
+	}
+	d.b >>= n
+	d.nb -= n
+	return nil
+}
+
+// readByte reads a byte from the input. The bit buffer must be at a byte
+// boundary.
+func (d *Decoder) readByte() (byte, error) {
+	if d.nb >= 8 {
+		c := byte(d.b)
+		d.b >>= 8
+		d.nb -= 8
+		return c, nil
+	}
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return 0, noEOF(err)
+	}
+	d.roffset++
+	return c, nil
+}
+
+// readFull fills buf from the input. The bit buffer must be at a byte
+// boundary.
+func (d *Decoder) readFull(buf []byte) error {
+	i := 0
+	for ; d.nb >= 8 && i < len(buf); i++ {
+		buf[i] = byte(d.b)
+		d.b >>= 8
+		d.nb -= 8
+	}
+	n, err := io.ReadFull(d.r, buf[i:])
+	d.roffset += int64(n)
+	return noEOF(err)
+}
+
+// Read the next Huffman-encoded symbol from d according to h.
+func (d *Decoder) huffSym(h *huffmanDecoder) (int, error) {
+	if h.singleSymbol {
+		return h.symbol, nil
+	}
+	// Since a huffmanDecoder can be empty, huffSym must error on this edge
+	// case. The chunks slice will be 0 for the invalid sequence, leading it
+	// satisfy the n == 0 check below.
+	n := uint(h.min)
+	// Optimization. Compiler isn't smart enough to keep d.b,d.nb in registers,
+	// but is smart enough to keep local variables in registers, so use nb and b,
+	// inline call to moreBits and reassign b,nb back to d on return.
+	nb, b := d.nb, d.b
+	for {
+		for nb < n {
+			c, err := d.r.ReadByte()
+			if err != nil {
+				d.b = b
+				d.nb = nb
+				return 0, noEOF(err)
+			}
+			d.roffset++
+			b |= uint32(c) << (nb & 31)
+			nb += 8
+		}
+		chunk := h.chunks[b&(huffmanNumChunks-1)]
+		n = uint(chunk & huffmanCountMask)
+		if n > huffmanChunkBits {
+			chunk = h.links[chunk>>huffmanValueShift][(b>>huffmanChunkBits)&h.linkMask]
+			n = uint(chunk & huffmanCountMask)
+		}
+		if n <= nb {
+			if n == 0 {
+				d.b = b
+				d.nb = nb
+				return 0, CorruptInputError(d.roffset)
+			}
+			d.b = b >> (n & 31)
+			d.nb = nb - n
+			return int(chunk >> huffmanValueShift), nil
+		}
+	}
+}
+
+// A Reader decompresses a Brotli stream.
+type Reader struct {
+	d       *Decoder
+	buf     []byte
+	pos     int
+	matches []pack.Match
+	err     error
+}
+
+// NewReader returns a Reader that decompresses Brotli data from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: NewDecoder(r)}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.matches, r.err = r.d.NextBlock(r.buf[:0], r.matches[:0])
+		r.pos = 0
+	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// Reset discards the Reader's state and prepares it to read a new stream
+// from src.
+func (r *Reader) Reset(src io.Reader) {
+	r.d.Reset(src)
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.matches = r.matches[:0]
+	r.err = nil
+}
+
+// SetDictionary sets the shared dictionary that the stream was compressed
+// with. See Decoder.SetDictionary.
+func (r *Reader) SetDictionary(dict []byte) {
+	r.d.SetDictionary(dict)
+}
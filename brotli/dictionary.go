@@ -0,0 +1,2163 @@
+package brotli
+
+/* Copyright 2013 Google Inc. All Rights Reserved.
+
+   Distributed under MIT license.
+   See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+*/
+
+/* Collection of static dictionary words. */
+
+const minDictionaryWordLength = 4
+
+const maxDictionaryWordLength = 24
+
+// dictionarySizeBitsByLength is the base-2 logarithm of the number of words
+// of each length in the dictionary.
+var dictionarySizeBitsByLength = [32]byte{
+	0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8,
+	7, 7, 8, 7, 7, 6, 6, 5, 5, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// dictionaryOffsetsByLength is the offset in dictionaryData of the first
+// word of each length.
+var dictionaryOffsetsByLength = [32]uint32{
+	0, 0, 0, 0, 0, 4096, 9216, 21504, 35840, 44032, 53248, 63488, 74752, 87040, 93696, 100864,
+	104704, 106752, 108928, 113536, 115968, 118528, 119872, 121280, 122016, 122784, 122784, 122784, 122784, 122784, 122784, 122784,
+}
+
+// dictionaryData is the static dictionary from RFC 7932, Appendix A.
+const dictionaryData = "" +
+	// 4-byte words
+	"timedownlifeleftbackcodedatashowonlysitecityopenjustlikefreework" +
+	"textyearoverbodyloveformbookplaylivelinehelphomesidemorewordlong" +
+	"themviewfindpagedaysfullheadtermeachareafromtruemarkableuponhigh" +
+	"datelandnewsevennextcasebothpostusedmadehandherewhatnameLinkblog" +
+	"sizebaseheldmakemainuser') +holdendswithNewsreadweresigntakehave" +
+	"gameseencallpathwellplusmenufilmpartjointhislistgoodneedwayswest" +
+	"jobsmindalsologorichuseslastteamarmyfoodkingwilleastwardbestfire" +
+	"Pageknowaway.pngmovethanloadgiveselfnotemuchfeedmanyrockicononce" +
+	"lookhidediedHomerulehostajaxinfoclublawslesshalfsomesuchzone100%" +
+	"onescareTimeracebluefourweekfacehopegavehardlostwhenparkkeptpass" +
+	"shiproomHTMLplanTypedonesavekeepflaglinksoldfivetookratetownjump" +
+	"thusdarkcardfilefearstaykillthatfallautoever.comtalkshopvotedeep" +
+	"moderestturnbornbandfellroseurl(skinrolecomeactsagesmeetgold.jpg" +
+	"itemvaryfeltthensenddropViewcopy1.0\"</a>stopelseliestourpack.gif" +
+	"pastcss?graymean&gt;rideshotlatesaidroadvar feeljohnrickportfast" +
+	"'UA-dead</b>poorbilltypeU.S.woodmust2px;Inforankwidewantwalllead" +
+	"[0];paulwavesure$('#waitmassarmsgoesgainlangpaid!-- lockunitroot" +
+	"walkfirmwifexml\"songtest20pxkindrowstoolfontmailsafestarmapscore" +
+	"rainflowbabyspansays4px;6px;artsfootrealwikiheatsteptriporg/lake" +
+	"weaktoldFormcastfansbankveryrunsjulytask1px;goalgrewslowedgeid=\"" +
+	"sets5px;.js?40pxif (soonseatnonetubezerosentreedfactintogiftharm" +
+	"18pxcamehillboldzoomvoideasyringfillpeakinitcost3px;jacktagsbits" +
+	"rolleditknewnear<!--growJSONdutyNamesaleyou lotspainjazzcoldeyes" +
+	"fishwww.risktabsprev10pxrise25pxBlueding300,ballfordearnwildbox." +
+	"fairlackverspairjunetechif(!pickevil$(\"#warmlorddoespull,000idea" +
+	"drawhugespotfundburnhrefcellkeystickhourlossfuel12pxsuitdealRSS\"" +
+	"agedgreyGET\"easeaimsgirlaids8px;navygridtips#999warsladycars); }" +
+	"php?helltallwhomzh:\xe5*/\r\n 100hall.\n\nA7px;pushchat0px;crew*/</hash" +
+	"75pxflatrare && tellcampontolaidmissskiptentfinemalegetsplot400," +
+	"\r\n\r\ncoolfeet.php<br>ericmostguidbelldeschairmathatom/img&#82luck" +
+	"cent000;tinygonehtmlselldrugFREEnodenick?id=losenullvastwindRSS " +
+	"wearrelybeensamedukenasacapewishgulfT23:hitsslotgatekickblurthey" +
+	"15px''););\">msiewinsbirdsortbetaseekT18:ordstreemall60pxfarm’s" +
+	"boys[0].');\"POSTbearkids);}}marytend(UK)quadzh:\xe6-siz----prop');\r" +
+	"liftT19:viceandydebt>RSSpoolneckblowT16:doorevalT17:letsfailoral" +
+	"pollnovacolsgene —softrometillross<h3>pourfadepink<tr>mini)|!(" +
+	"minezh:\xe8barshear00);milk -->ironfreddiskwentsoilputs/js/holyT22:" +
+	"ISBNT20:adamsees<h2>json', 'contT21: RSSloopasiamoon</p>soulLINE" +
+	"fortcartT14:<h1>80px!--<9px;T04:mike:46ZniceinchYorkricezh:\xe4'));" +
+	"puremageparatonebond:37Z_of_']);000,zh:\xe7tankyardbowlbush:56ZJava" +
+	"30px\n|}\n%C3%:34ZjeffEXPIcashvisagolfsnowzh:\xe9quer.csssickmeatmin." +
+	"binddellhirepicsrent:36ZHTTP-201fotowolfEND xbox:54ZBODYdick;\n}\n" +
+	"exit:35Zvarsbeat'});diet999;anne}}</[i].Langkm²wiretoysaddsseal" +
+	"alex;\n\t}echonine.org005)tonyjewssandlegsroof000) 200winegeardogs" +
+	"bootgarycutstyletemption.xmlcockgang$('.50pxPh.Dmiscalanloandesk" +
+	"mileryanunixdisc);}\ndustclip).\n\n70px-200DVDs7]><tapedemoi++)wage" +
+	"europhiloptsholeFAQsasin-26TlabspetsURL bulkcook;}\r\nHEAD[0])abbr" +
+	"juan(198leshtwin</i>sonyguysfuckpipe|-\n!002)ndow[1];[];\nLog salt" +
+	"\r\n\t\tbangtrimbath){\r\n00px\n});ko:\xecfeesad>\rs:// [];tollplug(){\n{\r\n " +
+	".js'200pdualboat.JPG);\n}quot);\n\n');\n\r\n}\r201420152016201720182019" +
+	"2020202120222023202420252026202720282029203020312032203320342035" +
+	"2036203720132012201120102009200820072006200520042003200220012000" +
+	"1999199819971996199519941993199219911990198919881987198619851984" +
+	"1983198219811980197919781977197619751974197319721971197019691968" +
+	"1967196619651964196319621961196019591958195719561955195419531952" +
+	"1951195010001024139400009999comomásesteestaperotodohacecadaaño" +
+	"biendíaasívidacasootroforosolootracualdijosidograntipotemadebe" +
+	"algoquéestonadatrespococasabajotodasinoaguapuesunosantediceluis" +
+	"ellamayozonaamorpisoobraclicellodioshoracasiзанаомрару" +
+	"танепоотизнодотожеонихНаеебымыВы" +
+	"совывоНообПолиниРФНеМытыОнимдаЗа" +
+	"ДаНуОбтеИзейнуммТыужفيأنمامعكلأو" +
+	"رديافىهولملكاولهبسالإنهيأيقدهلثم" +
+	"بهلوليبلايبكشيامأمنتبيلنحبهممشوش" +
+
+	// 5-byte words
+	"firstvideolightworldmediawhitecloseblackrightsmallbooksplace" +
+	"musicfieldorderpointvalueleveltableboardhousegroupworksyears" +
+	"statetodaywaterstartstyledeathpowerphonenighterrorinputabout" +
+	"termstitletoolseventlocaltimeslargewordsgamesshortspacefocus" +
+	"clearmodelblockguideradiosharewomenagainmoneyimagenamesyoung" +
+	"lineslatercolorgreenfront&amp;watchforcepricerulesbeginafter" +
+	"visitissueareasbelowindextotalhourslabelprintpressbuiltlinks" +
+	"speedstudytradefoundsenseundershownformsrangeaddedstillmoved" +
+	"takenaboveflashfixedoftenotherviewschecklegalriveritemsquick" +
+	"shapehumanexistgoingmoviethirdbasicpeacestagewidthloginideas" +
+	"wrotepagesusersdrivestorebreaksouthvoicesitesmonthwherebuild" +
+	"whichearthforumthreesportpartyClicklowerlivesclasslayerentry" +
+	"storyusagesoundcourtyour birthpopuptypesapplyImagebeingupper" +
+	"noteseveryshowsmeansextramatchtrackknownearlybegansuperpaper" +
+	"northlearngivennamedendedTermspartsGroupbrandusingwomanfalse" +
+	"readyaudiotakeswhile.com/livedcasesdailychildgreatjudgethose" +
+	"unitsneverbroadcoastcoverapplefilescyclesceneplansclickwrite" +
+	"queenpieceemailframeolderphotolimitcachecivilscaleentertheme" +
+	"theretouchboundroyalaskedwholesincestock namefaithheartempty" +
+	"offerscopeownedmightalbumthinkbloodarraymajortrustcanonunion" +
+	"countvalidstoneStyleLoginhappyoccurleft:freshquitefilmsgrade" +
+	"needsurbanfightbasishoverauto;route.htmlmixedfinalYour slide" +
+	"topicbrownalonedrawnsplitreachRightdatesmarchquotegoodsLinks" +
+	"doubtasyncthumballowchiefyouthnovel10px;serveuntilhandsCheck" +
+	"Spacequeryjamesequaltwice0,000Startpanelsongsroundeightshift" +
+	"worthpostsleadsweeksavoidthesemilesplanesmartalphaplantmarks" +
+	"ratesplaysclaimsalestextsstarswrong</h3>thing.org/multiheard" +
+	"Powerstandtokensolid(thisbringshipsstafftriedcallsfullyfacts" +
+	"agentThis //-->adminegyptEvent15px;Emailtrue\"crossspentblogs" +
+	"box\">notedleavechinasizesguest</h4>robotheavytrue,sevengrand" +
+	"crimesignsawaredancephase><!--en_US&#39;200px_namelatinenjoy" +
+	"ajax.ationsmithU.S. holdspeterindianav\">chainscorecomesdoing" +
+	"priorShare1990sromanlistsjapanfallstrialowneragree</h2>abuse" +
+	"alertopera\"-//WcardshillsteamsPhototruthclean.php?saintmetal" +
+	"louismeantproofbriefrow\">genretrucklooksValueFrame.net/-->\n<" +
+	"try {\nvar makescostsplainadultquesttrainlaborhelpscausemagic" +
+	"motortheir250pxleaststepsCountcouldglasssidesfundshotelaward" +
+	"mouthmovesparisgivesdutchtexasfruitnull,||[];top\">\n<!--POST\"" +
+	"ocean<br/>floorspeakdepth sizebankscatchchart20px;aligndeals" +
+	"would50px;url=\"parksmouseMost ...</amongbrainbody none;based" +
+	"carrydraftreferpage_home.meterdelaydreamprovejoint</tr>drugs" +
+	"<!-- aprilidealallenexactforthcodeslogicView seemsblankports" +
+	" (200saved_linkgoalsgrantgreekhomesringsrated30px;whoseparse" +
+	"();\" Blocklinuxjonespixel');\">);if(-leftdavidhorseFocusraise" +
+	"boxesTrackement</em>bar\">.src=toweralt=\"cablehenry24px;setup" +
+	"italysharpminortastewantsthis.resetwheelgirls/css/100%;clubs" +
+	"stuffbiblevotes 1000korea});\r\nbandsqueue= {};80px;cking{\r\n\t\t" +
+	"aheadclockirishlike ratiostatsForm\"yahoo)[0];Aboutfinds</h1>" +
+	"debugtasksURL =cells})();12px;primetellsturns0x600.jpg\"spain" +
+	"beachtaxesmicroangel--></giftssteve-linkbody.});\n\tmount (199" +
+	"FAQ</rogerfrankClass28px;feeds<h1><scotttests22px;drink) || " +
+	"lewisshall#039; for lovedwaste00px;ja:\xe3\x82simon<fontreplymeets" +
+	"untercheaptightBrand) != dressclipsroomsonkeymobilmain.Name " +
+	"platefunnytreescom/\"1.jpgwmodeparamSTARTleft idden, 201);\n}\n" +
+	"form.viruschairtransworstPagesitionpatch<!--\no-cacfirmstours" +
+	",000 asiani++){adobe')[0]id=10both;menu .2.mi.png\"kevincoach" +
+	"Childbruce2.jpgURL)+.jpg|suitesliceharry120\" sweettr>\r\nname=" +
+	"diegopage swiss-->\n\n#fff;\">Log.com\"treatsheet) && 14px;sleep" +
+	"ntentfiledja:\xe3\x83id=\"cName\"worseshots-box-delta\n&lt;bears:48Z<" +
+	"data-rural</a> spendbakershops= \"\";php\">ction13px;brianhello" +
+	"size=o=%2F joinmaybe<img img\">, fjsimg\" \")[0]MTopBType\"newly" +
+	"Danskczechtrailknows</h5>faq\">zh-cn10);\n-1\");type=bluestruly" +
+	"davis.js';>\r\n<!steel you h2>\r\nform jesus100% menu.\r\n\t\r\nwales" +
+	"risksumentddingb-likteachgif\" vegasdanskeestishqipsuomisobre" +
+	"desdeentretodospuedeañosestátienehastaotrospartedondenuevo" +
+	"hacerformamismomejormundoaquídíassóloayudafechatodastanto" +
+	"menosdatosotrassitiomuchoahoralugarmayorestoshorastenerantes" +
+	"fotosestaspaísnuevasaludforosmedioquienmesespoderchileserá" +
+	"vecesdecirjoséestarventagrupohechoellostengoamigocosasnivel" +
+	"gentemismaairesjuliotemashaciafavorjuniolibrepuntobuenoautor" +
+	"abrilbuenatextomarzosaberlistaluegocómoenerojuegoperúhaber" +
+	"estoynuncamujervalorfueralibrogustaigualvotoscasosguíapuedo" +
+	"somosavisousteddebennochebuscafaltaeurosseriedichocursoclave" +
+	"casasleónplazolargoobrasvistaapoyojuntotratavistocrearcampo" +
+	"hemoscincocargopisosordenhacenáreadiscopedrocercapuedapapel" +
+	"menorútilclarojorgecalleponertardenadiemarcasigueellassiglo" +
+	"cochemotosmadreclaserestoniñoquedapasarbancohijosviajepablo" +
+	"éstevienereinodejarfondocanalnorteletracausatomarmanoslunes" +
+	"autosvillavendopesartipostengamarcollevapadreunidovamoszonas" +
+	"ambosbandamariaabusomuchasubirriojavivirgradochicaallíjoven" +
+	"dichaestantalessalirsuelopesosfinesllamabuscoéstalleganegro" +
+	"plazahumorpagarjuntadobleislasbolsabañohablaluchaÁreadicen" +
+	"jugarnotasvalleallácargadolorabajoestégustomentemariofirma" +
+	"costofichaplatahogarartesleyesaquelmuseobasespocosmitadcielo" +
+	"chicomiedoganarsantoetapadebesplayaredessietecortecoreadudas" +
+	"deseoviejodeseaaguas" +
+
+	// 6-byte words
+	"&quot;domaincommonstatuseventsmastersystemactionbannerremove" +
+	"scrollupdateglobalmediumfilternumberchangeresultpublicscreen" +
+	"choosenormaltravelissuessourcetargetspringmodulemobileswitch" +
+	"photosborderregionitselfsocialactivecolumnrecordfollowtitle>" +
+	"eitherlengthfamilyfriendlayoutauthorcreatereviewsummerserver" +
+	"playedplayerexpandpolicyformatdoublepointsseriespersonliving" +
+	"designmonthsforcesuniqueweightpeopleenergynaturesearchfigure" +
+	"havingcustomoffsetletterwindowsubmitrendergroupsuploadhealth" +
+	"methodvideosschoolfutureshadowdebatevaluesObjectothersrights" +
+	"leaguechromesimplenoticesharedendingseasonreportonlinesquare" +
+	"buttonimagesenablemovinglatestwinterFranceperiodstrongrepeat" +
+	"Londondetailformeddemandsecurepassedtoggleplacesdevicestatic" +
+	"citiesstreamyellowattackstreetflighthiddeninfo\">openeduseful" +
+	"valleycausesleadersecretseconddamagesportsexceptratingsigned" +
+	"thingseffectfieldsstatesofficevisualeditorvolumeReportmuseum" +
+	"moviesparentaccessmostlymother\" id=\"marketgroundchancesurvey" +
+	"beforesymbolmomentspeechmotioninsidematterCenterobjectexists" +
+	"middleEuropegrowthlegacymannerenoughcareeransweroriginportal" +
+	"clientselectrandomclosedtopicscomingfatheroptionsimplyraised" +
+	"escapechosenchurchdefinereasoncorneroutputmemoryiframepolice" +
+	"modelsNumberduringoffersstyleskilledlistedcalledsilvermargin" +
+	"deletebetterbrowselimitsGlobalsinglewidgetcenterbudgetnowrap" +
+	"creditclaimsenginesafetychoicespirit-stylespreadmakingneeded" +
+	"russiapleaseextentScriptbrokenallowschargedividefactormember" +
+	"-basedtheoryconfigaroundworkedhelpedChurchimpactshouldalways" +
+	"logo\" bottomlist\">){var prefixorangeHeader.push(couplegarden" +
+	"bridgelaunchReviewtakingvisionlittledatingButtonbeautythemes" +
+	"forgotSearchanchoralmostloadedChangereturnstringreloadMobile" +
+	"incomesupplySourceordersviewed&nbsp;courseAbout island<html " +
+	"cookiename=\"amazonmodernadvicein</a>: The dialoghousesBEGIN " +
+	"MexicostartscentreheightaddingIslandassetsEmpireSchooleffort" +
+	"directnearlymanualSelect.\n\nOnejoinedmenu\">Philipawardshandle" +
+	"importOfficeregardskillsnationSportsdegreeweekly (e.g.behind" +
+	"doctorloggedunited</b></beginsplantsassistartistissued300px|" +
+	"canadaagencyschemeremainBrazilsamplelogo\">beyond-scaleaccept" +
+	"servedmarineFootercamera</h1>\n_form\"leavesstress\" />\r\n.gif\" " +
+	"onloadloaderOxfordsistersurvivlistenfemaleDesignsize=\"appeal" +
+	"text\">levelsthankshigherforcedanimalanyoneAfricaagreedrecent" +
+	"People<br />wonderpricesturned|| {};main\">inlinesundaywrap\">" +
+	"failedcensusminutebeaconquotes150px|estateremoteemail\"linked" +
+	"right;signalformal1.htmlsignupprincefloat:.png\" forum.Access" +
+	"paperssoundsextendHeightsliderUTF-8\"&amp; Before. Withstudio" +
+	"ownersmanageprofitjQueryannualparamsboughtfamousgooglelonger" +
+	"i++) {israelsayingdecidehome\">headerensurebranchpiecesblock;" +
+	"statedtop\"><racingresize--&gt;pacitysexualbureau.jpg\" 10,000" +
+	"obtaintitlesamount, Inc.comedymenu\" lyricstoday.indeedcounty" +
+	"_logo.FamilylookedMarketlse ifPlayerturkey);var forestgiving" +
+	"errorsDomain}else{insertBlog</footerlogin.fasteragents<body " +
+	"10px 0pragmafridayjuniordollarplacedcoversplugin5,000 page\">" +
+	"boston.test(avatartested_countforumsschemaindex,filledshares" +
+	"readeralert(appearSubmitline\">body\">\n* TheThoughseeingjersey" +
+	"News</verifyexpertinjurywidth=CookieSTART across_imagethread" +
+	"nativepocketbox\">\nSystem DavidcancertablesprovedApril really" +
+	"driveritem\">more\">boardscolorscampusfirst || [];media.guitar" +
+	"finishwidth:showedOther .php\" assumelayerswilsonstoresrelief" +
+	"swedenCustomeasily your String\n\nWhiltaylorclear:resortfrench" +
+	"though\") + \"<body>buyingbrandsMembername\">oppingsector5px;\">" +
+	"vspacepostermajor coffeemartinmaturehappen</nav>kansaslink\">" +
+	"Images=falsewhile hspace0&amp; \n\nIn  powerPolski-colorjordan" +
+	"BottomStart -count2.htmlnews\">01.jpgOnline-rightmillersenior" +
+	"ISBN 00,000 guidesvalue)ectionrepair.xml\"  rights.html-block" +
+	"regExp:hoverwithinvirginphones</tr>\rusing \n\tvar >');\n\t</td>\n" +
+	"</tr>\nbahasabrasilgalegomagyarpolskisrpskiردو中文简体" +
+	"繁體信息中国我们一个公司管理论坛可以服务" +
+	"时间个人产品自己企业查看工作联系没有网站" +
+	"所有评论中心文章用户首页作者技术问题相关" +
+	"下载搜索使用软件在线主题资料视频回复注册" +
+	"网络收藏内容推荐市场消息空间发布什么好友" +
+	"生活图片发展如果手机新闻最新方式北京提供" +
+	"关于更多这个系统知道游戏广告其他发表安全" +
+	"第一会员进行点击版权电子世界设计免费教育" +
+	"加入活动他们商品博客现在上海如何已经留言" +
+	"详细社区登录本站需要价格支持国际链接国家" +
+	"建设朋友阅读法律位置经济选择这样当前分类" +
+	"排行因为交易最后音乐不能通过行业科技可能" +
+	"设备合作大家社会研究专业全部项目这里还是" +
+	"开始情况电脑文件品牌帮助文化资源大学学习" +
+	"地址浏览投资工程要求怎么时候功能主要目前" +
+	"资讯城市方法电影招聘声明任何健康数据美国" +
+	"汽车介绍但是交流生产所以电话显示一些单位" +
+	"人员分析地图旅游工具学生系列网友帖子密码" +
+	"频道控制地区基本全国网上重要第二喜欢进入" +
+	"友情这些考试发现培训以上政府成为环境香港" +
+	"同时娱乐发送一定开发作品标准欢迎解决地方" +
+	"一下以及责任或者客户代表积分女人数码销售" +
+	"出现离线应用列表不同编辑统计查询不要有关" +
+	"机构很多播放组织政策直接能力来源時間看到" +
+	"热门关键专区非常英语百度希望美女比较知识" +
+	"规定建议部门意见精彩日本提高发言方面基金" +
+	"处理权限影片银行还有分享物品经营添加专家" +
+	"这种话题起来业务公告记录简介质量男人影响" +
+	"引用报告部分快速咨询时尚注意申请学校应该" +
+	"历史只是返回购买名称为了成功说明供应孩子" +
+	"专题程序一般會員只有其它保护而且今天窗口" +
+	"动态状态特别认为必须更新小说我們作为媒体" +
+	"包括那么一样国内是否根据电视学院具有过程" +
+	"由于人才出来不过正在明星故事关系标题商务" +
+	"输入一直基础教学了解建筑结果全球通知计划" +
+	"对于艺术相册发生真的建立等级类型经验实现" +
+	"制作来自标签以下原创无法其中個人一切指南" +
+	"关闭集团第三关注因此照片深圳商业广州日期" +
+	"高级最近综合表示专辑行为交通评价觉得精华" +
+	"家庭完成感觉安装得到邮件制度食品虽然转载" +
+	"报价记者方案行政人民用品东西提出酒店然后" +
+	"付款热点以前完全发帖设置领导工业医院看看" +
+	"经典原因平台各种增加材料新增之后职业效果" +
+	"今年论文我国告诉版主修改参与打印快乐机械" +
+	"观点存在精神获得利用继续你们这么模式语言" +
+	"能够雅虎操作风格一起科学体育短信条件治疗" +
+	"运动产业会议导航先生联盟可是問題结构作用" +
+	"调查資料自动负责农业访问实施接受讨论那个" +
+	"反馈加强女性范围服務休闲今日客服觀看参加" +
+	"的话一点保证图书有效测试移动才能决定股票" +
+	"不断需求不得办法之间采用营销投诉目标爱情" +
+	"摄影有些複製文学机会数字装修购物农村全面" +
+	"精品其实事情水平提示上市谢谢普通教师上传" +
+	"类别歌曲拥有创新配件只要时代資訊达到人生" +
+	"订阅老师展示心理贴子網站主題自然级别简单" +
+	"改革那些来说打开代码删除证券节目重点次數" +
+	"多少规划资金找到以后大全主页最佳回答天下" +
+	"保障现代检查投票小时沒有正常甚至代理目录" +
+	"公开复制金融幸福版本形成准备行情回到思想" +
+	"怎样协议认证最好产生按照服装广东动漫采购" +
+	"新手组图面板参考政治容易天地努力人们升级" +
+	"速度人物调整流行造成文字韩国贸易开展相關" +
+	"表现影视如此美容大小报道条款心情许多法规" +
+	"家居书店连接立即举报技巧奥运登入以来理论" +
+	"事件自由中华办公妈妈真正不错全文合同价值" +
+	"别人监督具体世纪团队创业承担增长有人保持" +
+	"商家维修台湾左右股份答案实际电信经理生命" +
+	"宣传任务正式特色下来协会只能当然重新內容" +
+	"指导运行日志賣家超过土地浙江支付推出站长" +
+	"杭州执行制造之一推广现场描述变化传统歌手" +
+	"保险课程医疗经过过去之前收入年度杂志美丽" +
+	"最高登陆未来加工免责教程版块身体重庆出售" +
+	"成本形式土豆出價东方邮箱南京求职取得职位" +
+	"相信页面分钟网页确定图例网址积极错误目的" +
+	"宝贝机关风险授权病毒宠物除了評論疾病及时" +
+	"求购站点儿童每天中央认识每个天津字体台灣" +
+	"维护本页个性官方常见相机战略应当律师方便" +
+	"校园股市房屋栏目员工导致突然道具本网结合" +
+	"档案劳动另外美元引起改变第四会计說明隐私" +
+	"宝宝规范消费共同忘记体系带来名字發表开放" +
+	"加盟受到二手大量成人数量共享区域女孩原则" +
+	"所在结束通信超级配置当时优秀性感房产遊戲" +
+	"出口提交就业保健程度参数事业整个山东情感" +
+	"特殊分類搜尋属于门户财务声音及其财经坚持" +
+	"干部成立利益考虑成都包装用戶比赛文明招商" +
+	"完整真是眼睛伙伴威望领域卫生优惠論壇公共" +
+	"良好充分符合附件特点不可英文资产根本明显" +
+	"密碼公众民族更加享受同学启动适合原来问答" +
+	"本文美食绿色稳定终于生物供求搜狐力量严重" +
+	"永远写真有限竞争对象费用不好绝对十分促进" +
+	"点评影音优势不少欣赏并且有点方向全新信用" +
+	"设施形象资格突破随着重大于是毕业智能化工" +
+	"完美商城统一出版打造產品概况用于保留因素" +
+	"中國存储贴图最愛长期口价理财基地安排武汉" +
+	"里面创建天空首先完善驱动下面不再诚信意义" +
+	"阳光英国漂亮军事玩家群众农民即可名稱家具" +
+	"动画想到注明小学性能考研硬件观看清楚搞笑" +
+	"首頁黄金适用江苏真实主管阶段註冊翻译权利" +
+	"做好似乎通讯施工狀態也许环保培养概念大型" +
+	"机票理解匿名cuandoenviarmadridbuscariniciotiempoporque" +
+	"cuentaestadopuedenjuegoscontraestánnombretienenperfilmanera" +
+	"amigosciudadcentroaunquepuedesdentroprimerpreciosegúnbuenos" +
+	"volverpuntossemanahabíaagostonuevosunidoscarlosequiponiños" +
+	"muchosalgunacorreoimagenpartirarribamaríahombreempleoverdad" +
+	"cambiomuchasfueronpasadolíneaparecenuevascursosestabaquiero" +
+	"libroscuantoaccesomiguelvarioscuatrotienesgruposseráneuropa" +
+	"mediosfrenteacercademásofertacochesmodeloitalialetrasalgún" +
+	"compracualesexistecuerposiendoprensallegarviajesdineromurcia" +
+	"podrápuestodiariopuebloquieremanuelpropiocrisisciertoseguro" +
+	"muertefuentecerrargrandeefectopartesmedidapropiaofrecetierra" +
+	"e-mailvariasformasfuturoobjetoseguirriesgonormasmismosúnico" +
+	"caminositiosrazóndebidopruebatoledoteníajesúsesperococina" +
+	"origentiendacientocádizhablarseríalatinafuerzaestiloguerra" +
+	"entraréxitolópezagendavídeoevitarpaginametrosjavierpadres" +
+	"fácilcabezaáreassalidaenvíojapónabusosbienestextosllevar" +
+	"puedanfuertecomúnclaseshumanotenidobilbaounidadestáseditar" +
+	"creadoдлячтокакилиэтовсеегопритак" +
+	"ещеужеКакбезбылониВсеподЭтотом" +
+	"чемнетлетразонагдемнеДляПринас" +
+	"нихтемктогодвоттамСШАмаяЧтовас" +
+	"вамемуТакдванамэтиэтуВамтехпро" +
+	"тутнаддняВоттринейВаснимсамтот" +
+	"рубОнимирнееОООлицэтаОнанемдом" +
+	"мойдвеоносудकेहैकीसेकाको" +
+	"औरपरनेएककिभीइसकरतोहो" +
+	"आपहीयहयातकथाjagranआजजोअब" +
+	"दोगईजागएहमइनवहयेथेथी" +
+	"घरजबदीकईजीवेनईनएहरउस" +
+	"मेकमवोलेसबमईदेओरआमबस" +
+	"भरबनचलमनआगसीलीعلىإلىهذا" +
+	"آخرعددالىهذهصورغيركانولابينعرض" +
+	"ذلكهنايومقالعليانالكنحتىقبلوحة" +
+	"اخرفقطعبدركنإذاكمااحدإلافيهبعض" +
+	"كيفبحثومنوهوأناجدالهاسلمعندليس" +
+	"عبرصلىمنذبهاأنهمثلكنتالاحيثمصر" +
+	"شرححولوفياذالكلمرةانتالفأبوخاص" +
+	"أنتانهاليعضووقدابنخيربنتلكمشاء" +
+	"وهيابوقصصومارقمأحدنحنعدمرأياحة" +
+	"كتبدونيجبمنهتحتجهةسنةيتمكرةغزة" +
+	"نفسبيتللهلناتلكقلبلماعنهأولشيء" +
+	"نورأمافيكبكلذاترتببأنهمسانكبيع" +
+	"فقدحسنلهمشعرأهلشهرقطرطلب" +
+
+	// 7-byte words
+	"profileservicedefaulthimselfdetailscontentsupportstartedmessage" +
+	"successfashion<title>countryaccountcreatedstoriesresultsrunning" +
+	"processwritingobjectsvisiblewelcomearticleunknownnetworkcompany" +
+	"dynamicbrowserprivacyproblemServicerespectdisplayrequestreserve" +
+	"websitehistoryfriendsoptionsworkingversionmillionchannelwindow." +
+	"addressvisitedweathercorrectproductedirectforwardyou canremoved" +
+	"subjectcontrolarchivecurrentreadinglibrarylimitedmanagerfurther" +
+	"summarymachineminutesprivatecontextprogramsocietynumberswritten" +
+	"enabledtriggersourcesloadingelementpartnerfinallyperfectmeaning" +
+	"systemskeepingculture&quot;,journalprojectsurfaces&quot;expires" +
+	"reviewsbalanceEnglishContentthroughPlease opinioncontactaverage" +
+	"primaryvillageSpanishgallerydeclinemeetingmissionpopularquality" +
+	"measuregeneralspeciessessionsectionwriterscounterinitialreports" +
+	"figuresmembersholdingdisputeearlierexpressdigitalpictureAnother" +
+	"marriedtrafficleadingchangedcentralvictoryimages/reasonsstudies" +
+	"featurelistingmust beschoolsVersionusuallyepisodeplayinggrowing" +
+	"obviousoverlaypresentactions</ul>\r\nwrapperalreadycertainreality" +
+	"storageanotherdesktopofferedpatternunusualDigitalcapitalWebsite" +
+	"failureconnectreducedAndroiddecadesregular &amp; animalsrelease" +
+	"AutomatgettingmethodsnothingPopularcaptionletterscapturescience" +
+	"licensechangesEngland=1&amp;History = new CentralupdatedSpecial" +
+	"NetworkrequirecommentwarningCollegetoolbarremainsbecauseelected" +
+	"DeutschfinanceworkersquicklybetweenexactlysettingdiseaseSociety" +
+	"weaponsexhibit&lt;!--Controlclassescoveredoutlineattacksdevices" +
+	"(windowpurposetitle=\"Mobile killingshowingItaliandroppedheavily" +
+	"effects-1']);\nconfirmCurrentadvancesharingopeningdrawingbillion" +
+	"orderedGermanyrelated</form>includewhetherdefinedSciencecatalog" +
+	"ArticlebuttonslargestuniformjourneysidebarChicagoholidayGeneral" +
+	"passage,&quot;animatefeelingarrivedpassingnaturalroughly.\n\nThe " +
+	"but notdensityBritainChineselack oftributeIreland\" data-factors" +
+	"receivethat isLibraryhusbandin factaffairsCharlesradicalbrought" +
+	"findinglanding:lang=\"return leadersplannedpremiumpackageAmerica" +
+	"Edition]&quot;Messageneed tovalue=\"complexlookingstationbelieve" +
+	"smaller-mobilerecordswant tokind ofFirefoxyou aresimilarstudied" +
+	"maximumheadingrapidlyclimatekingdomemergedamountsfoundedpioneer" +
+	"formuladynastyhow to SupportrevenueeconomyResultsbrothersoldier" +
+	"largelycalling.&quot;AccountEdward segmentRobert effortsPacific" +
+	"learnedup withheight:we haveAngelesnations_searchappliedacquire" +
+	"massivegranted: falsetreatedbiggestbenefitdrivingStudiesminimum" +
+	"perhapsmorningsellingis usedreversevariant role=\"missingachieve" +
+	"promotestudentsomeoneextremerestorebottom:evolvedall thesitemap" +
+	"englishway to  AugustsymbolsCompanymattersmusicalagainstserving" +
+	"})();\r\npaymenttroubleconceptcompareparentsplayersregionsmonitor" +
+	" ''The winningexploreadaptedGalleryproduceabilityenhancecareers" +
+	"). The collectSearch ancientexistedfooter handlerprintedconsole" +
+	"EasternexportswindowsChannelillegalneutralsuggest_headersigning" +
+	".html\">settledwesterncausing-webkitclaimedJusticechaptervictims" +
+	"Thomas mozillapromisepartieseditionoutside:false,hundredOlympic" +
+	"_buttonauthorsreachedchronicdemandssecondsprotectadoptedprepare" +
+	"neithergreatlygreateroverallimprovecommandspecialsearch.worship" +
+	"fundingthoughthighestinsteadutilityquarterCulturetestingclearly" +
+	"exposedBrowserliberal} catchProjectexamplehide();Floridaanswers" +
+	"allowedEmperordefenseseriousfreedomSeveral-buttonFurtherout of " +
+	"!= nulltrainedDenmarkvoid(0)/all.jspreventRequestStephen\n\nWhen " +
+	"observe</h2>\r\nModern provide\" alt=\"borders.\n\nFor \n\nMany artists" +
+	"poweredperformfictiontype ofmedicalticketsopposedCouncilwitness" +
+	"justiceGeorge Belgium...</a>twitternotablywaitingwarfare Other " +
+	"rankingphrasesmentionsurvivescholar</p>\r\n Countryignoredloss of" +
+	"just asGeorgiastrange<head><stopped1']);\r\nislandsnotableborder:" +
+	"list ofcarried100,000</h3>\n severalbecomesselect wedding00.html" +
+	"monarchoff theteacherhighly biologylife ofor evenrise of&raquo;" +
+	"plusonehunting(thoughDouglasjoiningcirclesFor theAncientVietnam" +
+	"vehiclesuch ascrystalvalue =Windowsenjoyeda smallassumed<a id=\"" +
+	"foreign All rihow theDisplayretiredhoweverhidden;battlesseeking" +
+	"cabinetwas notlook atconductget theJanuaryhappensturninga:hover" +
+	"Online French lackingtypicalextractenemieseven ifgeneratdecided" +
+	"are not/searchbeliefs-image:locatedstatic.login\">convertviolent" +
+	"enteredfirst\">circuitFinlandchemistshe was10px;\">as suchdivided" +
+	"</span>will beline ofa greatmystery/index.fallingdue to railway" +
+	"collegemonsterdescentit withnuclearJewish protestBritishflowers" +
+	"predictreformsbutton who waslectureinstantsuicidegenericperiods" +
+	"marketsSocial fishingcombinegraphicwinners<br /><by the Natural" +
+	"PrivacycookiesoutcomeresolveSwedishbrieflyPersianso muchCentury" +
+	"depictscolumnshousingscriptsnext tobearingmappingrevisedjQuery(" +
+	"-width:title\">tooltipSectiondesignsTurkishyounger.match(})();\n\n" +
+	"burningoperatedegreessource=Richardcloselyplasticentries</tr>\r\n" +
+	"color:#ul id=\"possessrollingphysicsfailingexecutecontestlink to" +
+	"Default<br />\n: true,chartertourismclassicproceedexplain</h1>\r\n" +
+	"online.?xml vehelpingdiamonduse theairlineend -->).attr(readers" +
+	"hosting#ffffffrealizeVincentsignals src=\"/Productdespitediverse" +
+	"tellingPublic held inJoseph theatreaffects<style>a largedoesn't" +
+	"later, ElementfaviconcreatorHungaryAirportsee theso thatMichael" +
+	"SystemsPrograms, and  width=e&quot;tradingleft\">\npersonsGolden " +
+	"Affairsgrammarformingdestroyidea ofcase ofoldest this is.src = " +
+	"cartoonregistrCommonsMuslimsWhat isin manymarkingrevealsIndeed," +
+	"equally/show_aoutdoorescape(Austriageneticsystem,In the sitting" +
+	"He alsoIslandsAcademy\n\t\t<!--Daniel bindingblock\">imposedutilize" +
+	"Abraham(except{width:putting).html(|| [];\nDATA[ *kitchenmounted" +
+	"actual dialectmainly _blank'installexpertsif(typeIt also&copy; " +
+	"\">Termsborn inOptionseasterntalkingconcerngained ongoingjustify" +
+	"criticsfactoryits ownassaultinvitedlastinghis ownhref=\"/\" rel=\"" +
+	"developconcertdiagramdollarsclusterphp?id=alcohol);})();using a" +
+	"><span>vesselsrevivalAddressamateurandroidallegedillnesswalking" +
+	"centersqualifymatchesunifiedextinctDefensedied in\n\t<!-- customs" +
+	"linkingLittle Book ofeveningmin.js?are thekontakttoday's.html\" " +
+	"target=wearingAll Rig;\n})();raising Also, crucialabout\">declare" +
+	"-->\n<scfirefoxas muchappliesindex, s, but type = \n\r\n<!--towards" +
+	"RecordsPrivateForeignPremierchoicesVirtualreturnsCommentPowered" +
+	"inline;povertychamberLiving volumesAnthonylogin\" RelatedEconomy" +
+	"reachescuttinggravitylife inChapter-shadowNotable</td>\r\n return" +
+	"stadiumwidgetsvaryingtravelsheld bywho arework infacultyangular" +
+	"who hadairporttown of\n\nSome 'click'chargeskeywordit willcity of" +
+	"(this);Andrew unique checkedor more300px; return;rsion=\"plugins" +
+	"within herselfStationFederalventurepublishsent totensionactress" +
+	"come tofingersDuke ofpeople,exploitwhat isharmonya major\":\"http" +
+	"in his menu\">\nmonthlyofficercouncilgainingeven inSummarydate of" +
+	"loyaltyfitnessand wasemperorsupremeSecond hearingRussianlongest" +
+	"Albertalateralset of small\">.appenddo withfederalbank ofbeneath" +
+	"DespiteCapitalgrounds), and percentit fromclosingcontainInstead" +
+	"fifteenas well.yahoo.respondfighterobscurereflectorganic= Math." +
+	"editingonline paddinga wholeonerroryear ofend of barrierwhen it" +
+	"header home ofresumedrenamedstrong>heatingretainscloudfrway of " +
+	"March 1knowingin partBetweenlessonsclosestvirtuallinks\">crossed" +
+	"END -->famous awardedLicenseHealth fairly wealthyminimalAfrican" +
+	"competelabel\">singingfarmersBrasil)discussreplaceGregoryfont co" +
+	"pursuedappearsmake uproundedboth ofblockedsaw theofficescolours" +
+	"if(docuwhen heenforcepush(fuAugust UTF-8\">Fantasyin mostinjured" +
+	"Usuallyfarmingclosureobject defenceuse of Medical<body>\nevident" +
+	"be usedkeyCodesixteenIslamic#000000entire widely active (typeof" +
+	"one cancolor =speakerextendsPhysicsterrain<tbody>funeralviewing" +
+	"middle cricketprophetshifteddoctorsRussell targetcompactalgebra" +
+	"social-bulk ofman and</td>\n he left).val()false);logicalbanking" +
+	"home tonaming Arizonacredits);\n});\nfounderin turnCollinsbefore " +
+	"But thechargedTitle\">CaptainspelledgoddessTag -->Adding:but was" +
+	"Recent patientback in=false&Lincolnwe knowCounterJudaismscript " +
+	"altered']);\n  has theunclearEvent',both innot all\n\n<!-- placing" +
+	"hard to centersort ofclientsstreetsBernardassertstend tofantasy" +
+	"down inharbourFreedomjewelry/about..searchlegendsis mademodern " +
+	"only ononly toimage\" linear painterand notrarely acronymdeliver" +
+	"shorter00&amp;as manywidth=\"/* <![Ctitle =of the lowest picked " +
+	"escapeduses ofpeoples PublicMatthewtacticsdamagedway forlaws of" +
+	"easy to windowstrong  simple}catch(seventhinfoboxwent topainted" +
+	"citizenI don'tretreat. Some ww.\");\nbombingmailto:made in. Many " +
+	"carries||{};wiwork ofsynonymdefeatsfavoredopticalpageTraunless " +
+	"sendingleft\"><comScorAll thejQuery.touristClassicfalse\" Wilhelm" +
+	"suburbsgenuinebishops.split(global followsbody ofnominalContact" +
+	"secularleft tochiefly-hidden-banner</li>\n\n. When in bothdismiss" +
+	"Explorealways via thespañolwelfareruling arrangecaptainhis son" +
+	"rule ofhe tookitself,=0&amp;(calledsamplesto makecom/pagMartin " +
+	"Kennedyacceptsfull ofhandledBesides//--></able totargetsessence" +
+	"him to its by common.mineralto takeways tos.org/ladvisedpenalty" +
+	"simple:if theyLettersa shortHerbertstrikes groups.lengthflights" +
+	"overlapslowly lesser social </p>\n\t\tit intoranked rate oful>\r\n  " +
+	"attemptpair ofmake itKontaktAntoniohaving ratings activestreams" +
+	"trapped\").css(hostilelead tolittle groups,Picture-->\r\n\r\n rows=\"" +
+	" objectinverse<footerCustomV><\\/scrsolvingChamberslaverywounded" +
+	"whereas!= 'undfor allpartly -right:Arabianbacked centuryunit of" +
+	"mobile-Europe,is homerisk ofdesiredClintoncost ofage of become " +
+	"none ofp&quot;Middle ead')[0Criticsstudios>&copy;group\">assembl" +
+	"making pressedwidget.ps:\" ? rebuiltby someFormer editorsdelayed" +
+	"Canonichad thepushingclass=\"but arepartialBabylonbottom carrier" +
+	"Commandits useAs withcoursesa thirddenotesalso inHouston20px;\">" +
+	"accuseddouble goal ofFamous ).bind(priests Onlinein Julyst + \"g" +
+	"consultdecimalhelpfulrevivedis veryr'+'iptlosing femalesis also" +
+	"stringsdays ofarrivalfuture <objectforcingString(\" />\n\t\there is" +
+	"encoded.  The balloondone by/commonbgcolorlaw of Indianaavoided" +
+	"but the2px 3pxjquery.after apolicy.men andfooter-= true;for use" +
+	"screen.Indian image =family,http:// &nbsp;driverseternalsame as" +
+	"noticedviewers})();\n is moreseasonsformer the newis justconsent" +
+	" Searchwas thewhy theshippedbr><br>width: height=made ofcuisine" +
+	"is thata very Admiral fixed;normal MissionPress, ontariocharset" +
+	"try to invaded=\"true\"spacingis mosta more totallyfall of});\r\n  " +
+	"immensetime inset outsatisfyto finddown tolot of Playersin June" +
+	"quantumnot thetime todistantFinnishsrc = (single help ofGerman " +
+	"law andlabeledforestscookingspace\">header-well asStanleybridges" +
+	"/globalCroatia About [0];\n  it, andgroupedbeing a){throwhe made" +
+	"lighterethicalFFFFFF\"bottom\"like a employslive inas seenprinter" +
+	"most ofub-linkrejectsand useimage\">succeedfeedingNuclearinforma" +
+	"to helpWomen'sNeitherMexicanprotein<table by manyhealthylawsuit" +
+	"devised.push({sellerssimply Through.cookie Image(older\">us.js\">" +
+	" Since universlarger open to!-- endlies in']);\r\n  marketwho is " +
+	"(\"DOMComanagedone fortypeof Kingdomprofitsproposeto showcenter;" +
+	"made itdressedwere inmixtureprecisearisingsrc = 'make a secured" +
+	"Baptistvoting \n\t\tvar March 2grew upClimate.removeskilledway the" +
+	"</head>face ofacting right\">to workreduceshas haderectedshow();" +
+	"action=book ofan area== \"htt<header\n<html>conformfacing cookie." +
+	"rely onhosted .customhe wentbut forspread Family a meansout the" +
+	"forums.footage\">MobilClements\" id=\"as highintense--><!--female " +
+	"is seenimpliedset thea stateand hisfastestbesidesbutton_bounded" +
+	"\"><img Infoboxevents,a youngand areNative cheaperTimeoutand has" +
+	"engineswon the(mostlyright: find a -bottomPrince area ofmore of" +
+	"search_nature,legallyperiod,land ofor withinducedprovingmissile" +
+	"locallyAgainstthe wayk&quot;px;\">\r\npushed abandonnumeralCertain" +
+	"In thismore inor somename isand, incrownedISBN 0-createsOctober" +
+	"may notcenter late inDefenceenactedwish tobroadlycoolingonload=" +
+	"it. TherecoverMembersheight assumes<html>\npeople.in one =window" +
+	"footer_a good reklamaothers,to this_cookiepanel\">London,defines" +
+	"crushedbaptismcoastalstatus title\" move tolost inbetter implies" +
+	"rivalryservers SystemPerhapses and contendflowinglasted rise in" +
+	"Genesisview ofrising seem tobut in backinghe willgiven agiving " +
+	"cities.flow of Later all butHighwayonly bysign ofhe doesdiffers" +
+	"battery&amp;lasinglesthreatsintegertake onrefusedcalled =US&amp" +
+	"See thenativesby thissystem.head of:hover,lesbiansurnameand all" +
+	"common/header__paramsHarvard/pixel.removalso longrole ofjointly" +
+	"skyscraUnicodebr />\r\nAtlantanucleusCounty,purely count\">easily " +
+	"build aonclicka givenpointerh&quot;events else {\nditionsnow the" +
+	", with man whoorg/Webone andcavalryHe diedseattle00,000 {window" +
+	"have toif(windand itssolely m&quot;renewedDetroitamongsteither " +
+	"them inSenatorUs</a><King ofFrancis-produche usedart andhim and" +
+	"used byscoringat hometo haverelatesibilityfactionBuffalolink\"><" +
+	"what hefree toCity ofcome insectorscountedone daynervoussquare " +
+	"};if(goin whatimg\" alis onlysearch/tuesdaylooselySolomonsexual " +
+	"- <a hrmedium\"DO NOT France,with a war andsecond take a >\r\n\r\n\r\n" +
+	"market.highwaydone inctivity\"last\">obligedrise to\"undefimade to" +
+	" Early praisedin its for hisathleteJupiterYahoo! termed so many" +
+	"really s. The a woman?value=direct right\" bicycleacing=\"day and" +
+	"statingRather,higher Office are nowtimes, when a pay foron this" +
+	"-link\">;borderaround annual the Newput the.com\" takin toa brief" +
+	"(in thegroups.; widthenzymessimple in late{returntherapya point" +
+	"banninginks\">\n();\" rea place\\u003Caabout atr>\r\n\t\tccount gives a" +
+	"<SCRIPTRailwaythemes/toolboxById(\"xhumans,watchesin some if (wi" +
+	"coming formats Under but hashanded made bythan infear ofdenoted" +
+	"/iframeleft involtagein eacha&quot;base ofIn manyundergoregimes" +
+	"action </p>\r\n<ustomVa;&gt;</importsor thatmostly &amp;re size=\"" +
+	"</a></ha classpassiveHost = WhetherfertileVarious=[];(fucameras" +
+	"/></td>acts asIn some>\r\n\r\n<!organis <br />Beijingcatalàdeutsch" +
+	"europeueuskaragaeilgesvenskaespañamensajeusuariotrabajoméxico" +
+	"páginasiempresistemaoctubreduranteañadirempresamomentonuestro" +
+	"primeratravésgraciasnuestraprocesoestadoscalidadpersonanúmero" +
+	"acuerdomúsicamiembroofertasalgunospaísesejemploderechoademás" +
+	"privadoagregarenlacesposiblehotelessevillaprimeroúltimoeventos" +
+	"archivoculturamujeresentradaanuncioembargomercadograndesestudio" +
+	"mejoresfebrerodiseñoturismocódigoportadaespaciofamiliaantonio" +
+	"permiteguardaralgunaspreciosalguiensentidovisitastítuloconocer" +
+	"segundoconsejofranciaminutossegundatenemosefectosmálagasesión" +
+	"revistagranadacompraringresogarcíaacciónecuadorquienesincluso" +
+	"deberámateriahombresmuestrapodríamañanaúltimaestamosoficial" +
+	"tambienningúnsaludospodemosmejorar" +
+
+	// 8-byte words
+	"positionbusinesshomepagesecuritylanguagestandardcampaignfeatures" +
+	"categoryexternalchildrenreservedresearchexchangefavoritetemplate" +
+	"militaryindustryservicesmaterialproductsz-index:commentssoftware" +
+	"completecalendarplatformarticlesrequiredmovementquestionbuilding" +
+	"politicspossiblereligionphysicalfeedbackregisterpicturesdisabled" +
+	"protocolaudiencesettingsactivityelementslearninganythingabstract" +
+	"progressoverviewmagazineeconomictrainingpressurevarious <strong>" +
+	"propertyshoppingtogetheradvancedbehaviordownloadfeaturedfootball" +
+	"selectedLanguagedistanceremembertrackingpasswordmodifiedstudents" +
+	"directlyfightingnortherndatabasefestivalbreakinglocationinternet" +
+	"dropdownpracticeevidencefunctionmarriageresponseproblemsnegative" +
+	"programsanalysisreleasedbanner\">purchasepoliciesregionalcreative" +
+	"argumentbookmarkreferrerchemicaldivisioncallbackseparateprojects" +
+	"conflicthardwareinterestdeliverymountainobtained= false;for(var " +
+	"acceptedcapacitycomputeridentityaircraftemployedproposeddomestic" +
+	"includesprovidedhospitalverticalcollapseapproachpartnerslogo\"><a" +
+	"daughterauthor\" culturalfamilies/images/assemblypowerfulteaching" +
+	"finisheddistrictcriticalcgi-bin/purposesrequireselectionbecoming" +
+	"providesacademicexerciseactuallymedicineconstantaccidentMagazine" +
+	"documentstartingbottom\">observed: &quot;extendedpreviousSoftware" +
+	"customerdecisionstrengthdetailedslightlyplanningtextareacurrency" +
+	"everyonestraighttransferpositiveproducedheritageshippingabsolute" +
+	"receivedrelevantbutton\" violenceanywherebenefitslaunchedrecently" +
+	"alliancefollowedmultiplebulletinincludedoccurredinternal$(this)." +
+	"republic><tr><tdcongressrecordedultimatesolution<ul id=\"discover" +
+	"Home</a>websitesnetworksalthoughentirelymemorialmessagescontinue" +
+	"active\">somewhatvictoriaWestern  title=\"Locationcontractvisitors" +
+	"Downloadwithout right\">\nmeasureswidth = variableinvolvedvirginia" +
+	"normallyhappenedaccountsstandingnationalRegisterpreparedcontrols" +
+	"accuratebirthdaystrategyofficialgraphicscriminalpossiblyconsumer" +
+	"Personalspeakingvalidateachieved.jpg\" />machines</h2>\n  keywords" +
+	"friendlybrotherscombinedoriginalcomposedexpectedadequatepakistan" +
+	"follow\" valuable</label>relativebringingincreasegovernorplugins/" +
+	"List of Header\">\" name=\" (&quot;graduate</head>\ncommercemalaysia" +
+	"directormaintain;height:schedulechangingback to catholicpatterns" +
+	"color: #greatestsuppliesreliable</ul>\n\t\t<select citizensclothing" +
+	"watching<li id=\"specificcarryingsentence<center>contrastthinking" +
+	"catch(e)southernMichael merchantcarouselpadding:interior.split(\"" +
+	"lizationOctober ){returnimproved--&gt;\n\ncoveragechairman.png\" />" +
+	"subjectsRichard whateverprobablyrecoverybaseballjudgmentconnect." +
+	".css\" /> websitereporteddefault\"/></a>\r\nelectricscotlandcreation" +
+	"quantity. ISBN 0did not instance-search-\" lang=\"speakersComputer" +
+	"containsarchivesministerreactiondiscountItalianocriteriastrongly" +
+	": 'http:'script'coveringofferingappearedBritish identifyFacebook" +
+	"numerousvehiclesconcernsAmericanhandlingdiv id=\"William provider" +
+	"_contentaccuracysection andersonflexibleCategorylawrence<script>" +
+	"layout=\"approved maximumheader\"></table>Serviceshamiltoncurrent " +
+	"canadianchannels/themes//articleoptionalportugalvalue=\"\"interval" +
+	"wirelessentitledagenciesSearch\" measuredthousandspending&hellip;" +
+	"new Date\" size=\"pageNamemiddle\" \" /></a>hidden\">sequencepersonal" +
+	"overflowopinionsillinoislinks\">\n\t<title>versionssaturdayterminal" +
+	"itempropengineersectionsdesignerproposal=\"false\"Españolreleases" +
+	"submit\" er&quot;additionsymptomsorientedresourceright\"><pleasure" +
+	"stationshistory.leaving  border=contentscenter\">.\n\nSome directed" +
+	"suitablebulgaria.show();designedGeneral conceptsExampleswilliams" +
+	"Original\"><span>search\">operatorrequestsa &quot;allowingDocument" +
+	"revision. \n\nThe yourselfContact michiganEnglish columbiapriority" +
+	"printingdrinkingfacilityreturnedContent officersRussian generate" +
+	"-8859-1\"indicatefamiliar qualitymargin:0 contentviewportcontacts" +
+	"-title\">portable.length eligibleinvolvesatlanticonload=\"default." +
+	"suppliedpaymentsglossary\n\nAfter guidance</td><tdencodingmiddle\">" +
+	"came to displaysscottishjonathanmajoritywidgets.clinicalthailand" +
+	"teachers<head>\n\taffectedsupportspointer;toString</small>oklahoma" +
+	"will be investor0\" alt=\"holidaysResourcelicensed (which . After " +
+	"considervisitingexplorerprimary search\" android\"quickly meetings" +
+	"estimate;return ;color:# height=approval, &quot; checked.min.js\"" +
+	"magnetic></a></hforecast. While thursdaydvertise&eacute;hasClass" +
+	"evaluateorderingexistingpatients Online coloradoOptions\"campbell" +
+	"<!-- end</span><<br />\r\n_popups|sciences,&quot; quality Windows " +
+	"assignedheight: <b classle&quot; value=\" Companyexamples<iframe " +
+	"believespresentsmarshallpart of properly).\n\nThe taxonomymuch of " +
+	"</span>\n\" data-srtuguêsscrollTo project<head>\r\nattorneyemphasis" +
+	"sponsorsfancyboxworld's wildlifechecked=sessionsprogrammpx;font-" +
+	" Projectjournalsbelievedvacationthompsonlightingand the special " +
+	"border=0checking</tbody><button Completeclearfix\n<head>\narticle " +
+	"<sectionfindingsrole in popular  Octoberwebsite exposureused to " +
+	" changesoperatedclickingenteringcommandsinformed numbers  </div>" +
+	"creatingonSubmitmarylandcollegesanalyticlistingscontact.loggedIn" +
+	"advisorysiblingscontent\"s&quot;)s. This packagescheckboxsuggests" +
+	"pregnanttomorrowspacing=icon.pngjapanesecodebasebutton\">gambling" +
+	"such as , while </span> missourisportingtop:1px .</span>tensions" +
+	"width=\"2lazyloadnovemberused in height=\"cript\">\n&nbsp;</<tr><td " +
+	"height:2/productcountry include footer\" &lt;!-- title\"></jquery." +
+	"</form>\n(简体)(繁體)hrvatskiitalianoromânătürkçeاردو" +
+	"tambiénnoticiasmensajespersonasderechosnacionalserviciocontacto" +
+	"usuariosprogramagobiernoempresasanunciosvalenciacolombiadespués" +
+	"deportesproyectoproductopúbliconosotroshistoriapresentemillones" +
+	"mediantepreguntaanteriorrecursosproblemasantiagonuestrosopinión" +
+	"imprimirmientrasaméricavendedorsociedadrespectorealizarregistro" +
+	"palabrasinterésentoncesespecialmiembrosrealidadcórdobazaragoza" +
+	"páginassocialesbloqueargestiónalquilersistemascienciascompleto" +
+	"versióncompletaestudiospúblicaobjetivoalicantebuscadorcantidad" +
+	"entradasaccionesarchivossuperiormayoríaalemaniafunciónúltimos" +
+	"haciendoaquellosediciónfernandoambientefacebooknuestrasclientes" +
+	"procesosbastantepresentareportarcongresopublicarcomerciocontrato" +
+	"jóvenesdistritotécnicaconjuntoenergíatrabajarasturiasreciente" +
+	"utilizarboletínsalvadorcorrectatrabajosprimerosnegocioslibertad" +
+	"detallespantallapróximoalmeríaanimalesquiénescorazónsección" +
+	"buscandoopcionesexteriorconceptotodavíagaleríaescribirmedicina" +
+	"licenciaconsultaaspectoscríticadólaresjusticiadeberánperíodo" +
+	"necesitamantenerpequeñorecibidatribunaltenerifecancióncanarias" +
+	"descargadiversosmallorcarequieretécnicodeberíaviviendafinanzas" +
+	"adelantefuncionaconsejosdifícilciudadesantiguasavanzadatérmino" +
+	"unidadessánchezcampañasoftonicrevistascontienesectoresmomentos" +
+	"facultadcréditodiversassupuestofactoressegundospequeñaгода" +
+	"еслиестьбылобытьэтомЕслитогоменя" +
+	"всехэтойдажебылигодуденьэтотбыла" +
+	"себяодинсебенадосайтфотонегосвои" +
+	"свойигрытожевсемсвоюлишьэтихпока" +
+	"днейдомамиралиботемухотядвухсети" +
+	"людиделомиретебясвоевидечегоэтим" +
+	"счеттемыценысталведьтемеводытебе" +
+	"вышенамитипатомуправлицаоднагоды" +
+	"знаюмогудругвсейидеткинооднодела" +
+	"делесрокиюнявесьЕстьразанашиالله" +
+	"التيجميعخاصةالذيعليهجديدالآنالرد" +
+	"تحكمصفحةكانتاللييكونشبكةفيهابنات" +
+	"حواءأكثرخلالالحبدليلدروساضغطتكون" +
+	"هناكساحةناديالطبعليكشكرايمكنمنها" +
+	"شركةرئيسنشيطماذاالفنشبابتعبررحمة" +
+	"كافةيقولمركزكلمةأحمدقلبييعنيصورة" +
+	"طريقشاركجوالأخرىمعناابحثعروضبشكل" +
+	"مسجلبنانخالدكتابكليةبدونأيضايوجد" +
+	"فريقكتبتأفضلمطبخاكثرباركافضلاحلى" +
+	"نفسهأيامردودأنهاديناالانمعرضتعلم" +
+	"داخلممكن\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x04\x00\x04\x00\x04\x00\x04\x00\x00\x01\x02\x03\x04\x05\x06\a\a\x06\x05\x04\x03\x02\x01\x00" +
+	"\b\t\n\v\f\r\x0e\x0f\x0f\x0e\r\f\v\n\t\b\x10\x11\x12\x13\x14\x15\x16\x17\x17\x16\x15\x14\x13\x12\x11\x10\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f\x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff" +
+	"\x01\x00\x00\x00\x02\x00\x00\x00\x02\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x03\x00\x00\x00\xff\xff\x00\x01\x00\x00\x00\x01\x00\x00\xff\xff\x00\x01\x00\x00\x00\b\x00\b\x00\b\x00\b\x00\x00\x00\x01\x00\x02\x00\x03\x00\x04\x00\x05\x00\x06\x00\a" +
+
+	// 9-byte words
+	"resourcescountriesquestionsequipmentcommunityavailablehighlight" +
+	"DTD/xhtmlmarketingknowledgesomethingcontainerdirectionsubscribe" +
+	"advertisecharacter\" value=\"</select>Australia\" class=\"situation" +
+	"authorityfollowingprimarilyoperationchallengedevelopedanonymous" +
+	"function functionscompaniesstructureagreement\" title=\"potential" +
+	"educationargumentssecondarycopyrightlanguagesexclusivecondition" +
+	"</form>\r\nstatementattentionBiography} else {\nsolutionswhen the " +
+	"Analyticstemplatesdangeroussatellitedocumentspublisherimportant" +
+	"prototypeinfluence&raquo;</effectivegenerallytransformbeautiful" +
+	"transportorganizedpublishedprominentuntil thethumbnailNational " +
+	".focus();over the migrationannouncedfooter\">\nexceptionless than" +
+	"expensiveformationframeworkterritoryndicationcurrentlyclassName" +
+	"criticismtraditionelsewhereAlexanderappointedmaterialsbroadcast" +
+	"mentionedaffiliate</option>treatmentdifferent/default.President" +
+	"onclick=\"biographyotherwisepermanentFrançaisHollywoodexpansion" +
+	"standards</style>\nreductionDecember preferredCambridgeopponents" +
+	"Business confusion>\n<title>presentedexplaineddoes not worldwide" +
+	"interfacepositionsnewspaper</table>\nmountainslike the essential" +
+	"financialselectionaction=\"/abandonedEducationparseInt(stability" +
+	"unable to</title>\nrelationsNote thatefficientperformedtwo years" +
+	"Since thethereforewrapper\">alternateincreasedBattle ofperceived" +
+	"trying tonecessaryportrayedelectionsElizabeth</iframe>discovery" +
+	"insurances.length;legendaryGeographycandidatecorporatesometimes" +
+	"services.inherited</strong>CommunityreligiouslocationsCommittee" +
+	"buildingsthe worldno longerbeginningreferencecannot befrequency" +
+	"typicallyinto the relative;recordingpresidentinitiallytechnique" +
+	"the otherit can beexistenceunderlinethis timetelephoneitemscope" +
+	"practicesadvantage);return For otherprovidingdemocracyboth the " +
+	"extensivesufferingsupportedcomputers functionpracticalsaid that" +
+	"it may beEnglish</from the scheduleddownloads</label>\nsuspected" +
+	"margin: 0spiritual</head>\n\nmicrosoftgraduallydiscussedhe became" +
+	"executivejquery.jshouseholdconfirmedpurchasedliterallydestroyed" +
+	"up to thevariationremainingit is notcenturiesJapanese among the" +
+	"completedalgorithminterestsrebellionundefinedencourageresizable" +
+	"involvingsensitiveuniversalprovision(althoughfeaturingconducted" +
+	"), which continued-header\">February numerous overflow:component" +
+	"fragmentsexcellentcolspan=\"technicalnear the Advanced source of" +
+	"expressedHong Kong Facebookmultiple mechanismelevationoffensive" +
+	"</form>\n\tsponsoreddocument.or &quot;there arethose whomovements" +
+	"processesdifficultsubmittedrecommendconvincedpromoting\" width=\"" +
+	".replace(classicalcoalitionhis firstdecisionsassistantindicated" +
+	"evolution-wrapper\"enough toalong thedelivered-->\r\n<!--American " +
+	"protectedNovember </style><furnitureInternet  onblur=\"suspended" +
+	"recipientbased on Moreover,abolishedcollectedwere madeemotional" +
+	"emergencynarrativeadvocatespx;bordercommitteddir=\"ltr\"employees" +
+	"research. selectedsuccessorcustomersdisplayedSeptemberaddClass(" +
+	"Facebook suggestedand lateroperatingelaborateSometimesInstitute" +
+	"certainlyinstalledfollowersJerusalemthey havecomputinggenerated" +
+	"provincesguaranteearbitraryrecognizewanted topx;width:theory of" +
+	"behaviourWhile theestimatedbegan to it becamemagnitudemust have" +
+	"more thanDirectoryextensionsecretarynaturallyoccurringvariables" +
+	"given theplatform.</label><failed tocompoundskinds of societies" +
+	"alongside --&gt;\n\nsouthwestthe rightradiationmay have unescape(" +
+	"spoken in\" href=\"/programmeonly the come fromdirectoryburied in" +
+	"a similarthey were</font></Norwegianspecifiedproducingpassenger" +
+	"(new DatetemporaryfictionalAfter theequationsdownload.regularly" +
+	"developerabove thelinked tophenomenaperiod oftooltip\">substance" +
+	"automaticaspect ofAmong theconnectedestimatesAir Forcesystem of" +
+	"objectiveimmediatemaking itpaintingsconqueredare stillprocedure" +
+	"growth ofheaded byEuropean divisionsmoleculesfranchiseintention" +
+	"attractedchildhoodalso useddedicatedsingaporedegree offather of" +
+	"conflicts</a></p>\ncame fromwere usednote thatreceivingExecutive" +
+	"even moreaccess tocommanderPoliticalmusiciansdeliciousprisoners" +
+	"advent ofUTF-8\" /><![CDATA[\">ContactSouthern bgcolor=\"series of" +
+	". It was in Europepermittedvalidate.appearingofficialsseriously" +
+	"-languageinitiatedextendinglong-terminflationsuch thatgetCookie" +
+	"marked by</button>implementbut it isincreasesdown the requiring" +
+	"dependent-->\n<!-- interviewWith the copies ofconsensuswas built" +
+	"Venezuela(formerlythe statepersonnelstrategicfavour ofinvention" +
+	"Wikipediacontinentvirtuallywhich wasprincipleComplete identical" +
+	"show thatprimitiveaway frommolecularpreciselydissolvedUnder the" +
+	"version=\">&nbsp;</It is the This is will haveorganismssome time" +
+	"Friedrichwas firstthe only fact thatform id=\"precedingTechnical" +
+	"physicistoccurs innavigatorsection\">span id=\"sought tobelow the" +
+	"surviving}</style>his deathas in thecaused bypartiallyexisting " +
+	"using thewas givena list oflevels ofnotion ofOfficial dismissed" +
+	"scientistresemblesduplicateexplosiverecoveredall othergalleries" +
+	"{padding:people ofregion ofaddressesassociateimg alt=\"in modern" +
+	"should bemethod ofreportingtimestampneeded tothe Greatregarding" +
+	"seemed toviewed asimpact onidea thatthe Worldheight ofexpanding" +
+	"These arecurrent\">carefullymaintainscharge ofClassicaladdressed" +
+	"predictedownership<div id=\"right\">\r\nresidenceleave thecontent\">" +
+	"are often  })();\r\nprobably Professor-button\" respondedsays that" +
+	"had to beplaced inHungarianstatus ofserves asUniversalexecution" +
+	"aggregatefor whichinfectionagreed tohowever, popular\">placed on" +
+	"constructelectoralsymbol ofincludingreturn toarchitectChristian" +
+	"previous living ineasier toprofessor\n&lt;!-- effect ofanalytics" +
+	"was takenwhere thetook overbelief inAfrikaansas far asprevented" +
+	"work witha special<fieldsetChristmasRetrieved\n\nIn the back into" +
+	"northeastmagazines><strong>committeegoverninggroups ofstored in" +
+	"establisha generalits firsttheir ownpopulatedan objectCaribbean" +
+	"allow thedistrictswisconsinlocation.; width: inhabitedSocialist" +
+	"January 1</footer>similarlychoice ofthe same specific business " +
+	"The first.length; desire todeal withsince theuserAgentconceived" +
+	"index.phpas &quot;engage inrecently,few yearswere also\n<head>\n<" +
+	"edited byare knowncities inaccesskeycondemnedalso haveservices," +
+	"family ofSchool ofconvertednature of languageministers</object>" +
+	"there is a popularsequencesadvocatedThey wereany otherlocation=" +
+	"enter themuch morereflectedwas namedoriginal a typicalwhen they" +
+	"engineerscould notresidentswednesdaythe third productsJanuary 2" +
+	"what theya certainreactionsprocessorafter histhe last contained" +
+	"\"></div>\n</a></td>depend onsearch\">\npieces ofcompetingReference" +
+	"tennesseewhich has version=</span> <</header>gives thehistorian" +
+	"value=\"\">padding:0view thattogether,the most was foundsubset of" +
+	"attack onchildren,points ofpersonal position:allegedlyCleveland" +
+	"was laterand afterare givenwas stillscrollingdesign ofmakes the" +
+	"much lessAmericans.\n\nAfter , but theMuseum oflouisiana(from the" +
+	"minnesotaparticlesa processDominicanvolume ofreturningdefensive" +
+	"00px|righmade frommouseover\" style=\"states of(which iscontinues" +
+	"Franciscobuilding without awith somewho woulda form ofa part of" +
+	"before itknown as  Serviceslocation and oftenmeasuringand it is" +
+	"paperbackvalues of\r\n<title>= window.determineer&quot; played by" +
+	"and early</center>from thisthe threepower andof &quot;innerHTML" +
+	"<a href=\"y:inline;Church ofthe eventvery highofficial -height: " +
+	"content=\"/cgi-bin/to createafrikaansesperantofrançaislatviešu" +
+	"lietuviųČeštinačeštinaไทย日本語简体字繁體字" +
+	"한국어为什么计算机笔记本討論區服务器互联网" +
+	"房地产俱乐部出版社排行榜部落格进一步支付宝" +
+	"验证码委员会数据库消费者办公室讨论区深圳市" +
+	"播放器北京市大学生越来越管理员信息网servicios" +
+	"artículoargentinabarcelonacualquierpublicadoproductospolítica" +
+	"respuestawikipediasiguientebúsquedacomunidadseguridadprincipal" +
+	"preguntascontenidorespondervenezuelaproblemasdiciembrerelación" +
+	"noviembresimilaresproyectosprogramasinstitutoactividadencuentra" +
+	"economíaimágenescontactardescargarnecesarioatenciónteléfono" +
+	"comisióncancionescapacidadencontraranálisisfavoritostérminos" +
+	"provinciaetiquetaselementosfuncionesresultadocarácterpropiedad" +
+	"principionecesidadmunicipalcreacióndescargaspresenciacomercial" +
+	"opinionesejercicioeditorialsalamancagonzálezdocumentopelícula" +
+	"recientesgeneralestarragonaprácticanovedadespropuestapacientes" +
+	"técnicasobjetivoscontactosमेंलिएहैंगया" +
+	"साथएवंरहेकोईकुछरहाबाद" +
+	"कहासभीहुएरहीमैंदिनबात" +
+	"diplodocsसमयरूपनामपताफिरऔसत" +
+	"तरहलोगहुआबारदेशहुईखेल" +
+	"यदिकामवेबतीनबीचमौतसाल" +
+	"लेखजॉबमददतथानहीशहरअलग" +
+	"कभीनगरपासरातकिएउसेगयी" +
+	"हूँआगेटीमखोजकारअभीगये" +
+	"तुमवोटदेंअगरऐसेमेललगा" +
+	"हालऊपरचारऐसादेरजिसदिल" +
+	"बंदबनाहूंलाखजीतबटनमिल" +
+	"इसेआनेनयाकुललॉगभागरेल" +
+	"जगहरामलगेपेजहाथइसीसही" +
+	"कलाठीकहाँदूरतहतसातयाद" +
+	"आयापाककौनशामदेखयहीराय" +
+	"खुदलगी" +
+
+	// 10-byte words
+	"categoriesexperience</title>\r\nCopyright javascriptconditions" +
+	"everything<p class=\"technologybackground<a class=\"management" +
+	"&copy; 201javaScriptcharactersbreadcrumbthemselveshorizontal" +
+	"governmentCaliforniaactivitiesdiscoveredNavigationtransition" +
+	"connectionnavigationappearance</title><mcheckbox\" techniques" +
+	"protectionapparentlyas well asunt', 'UA-resolutionoperations" +
+	"televisiontranslatedWashingtonnavigator. = window.impression" +
+	"&lt;br&gt;literaturepopulationbgcolor=\"#especially content=\"" +
+	"productionnewsletterpropertiesdefinitionleadershipTechnology" +
+	"Parliamentcomparisonul class=\".indexOf(\"conclusiondiscussion" +
+	"componentsbiologicalRevolution_containerunderstoodnoscript><" +
+	"permissioneach otheratmosphere onfocus=\"<form id=\"processing" +
+	"this.valuegenerationConferencesubsequentwell-knownvariations" +
+	"reputationphenomenondisciplinelogo.png\" (document,boundaries" +
+	"expressionsettlementBackgroundout of theenterprise(\"https:\" " +
+	"unescape(\"password\" democratic<a href=\"/wrapper\">\nmembership" +
+	"linguisticpx;paddingphilosophyassistanceuniversityfacilities" +
+	"recognizedpreferenceif (typeofmaintainedvocabularyhypothesis" +
+	".submit();&amp;nbsp;annotationbehind theFoundationpublisher\"" +
+	"assumptionintroducedcorruptionscientistsexplicitlyinstead of" +
+	"dimensions onClick=\"considereddepartmentoccupationsoon after" +
+	"investmentpronouncedidentifiedexperimentManagementgeographic" +
+	"\" height=\"link rel=\".replace(/depressionconferencepunishment" +
+	"eliminatedresistanceadaptationoppositionwell knownsupplement" +
+	"determinedh1 class=\"0px;marginmechanicalstatisticscelebrated" +
+	"Government\n\nDuring tdevelopersartificialequivalentoriginated" +
+	"Commissionattachment<span id=\"there wereNederlandsbeyond the" +
+	"registeredjournalistfrequentlyall of thelang=\"en\" </style>\r\n" +
+	"absolute; supportingextremely mainstream</strong> popularity" +
+	"employment</table>\r\n colspan=\"</form>\n  conversionabout the " +
+	"</p></div>integrated\" lang=\"enPortuguesesubstituteindividual" +
+	"impossiblemultimediaalmost allpx solid #apart fromsubject to" +
+	"in Englishcriticizedexcept forguidelinesoriginallyremarkable" +
+	"the secondh2 class=\"<a title=\"(includingparametersprohibited" +
+	"= \"http://dictionaryperceptionrevolutionfoundationpx;height:" +
+	"successfulsupportersmillenniumhis fatherthe &quot;no-repeat;" +
+	"commercialindustrialencouragedamount of unofficialefficiency" +
+	"Referencescoordinatedisclaimerexpeditiondevelopingcalculated" +
+	"simplifiedlegitimatesubstring(0\" class=\"completelyillustrate" +
+	"five yearsinstrumentPublishing1\" class=\"psychologyconfidence" +
+	"number of absence offocused onjoined thestructurespreviously" +
+	"></iframe>once againbut ratherimmigrantsof course,a group of" +
+	"LiteratureUnlike the</a>&nbsp;\nfunction it was theConvention" +
+	"automobileProtestantaggressiveafter the Similarly,\" /></div>" +
+	"collection\r\nfunctionvisibilitythe use ofvolunteersattraction" +
+	"under the threatened*<![CDATA[importancein generalthe latter" +
+	"</form>\n</.indexOf('i = 0; i <differencedevoted totraditions" +
+	"search forultimatelytournamentattributesso-called }\n</style>" +
+	"evaluationemphasizedaccessible</section>successionalong with" +
+	"Meanwhile,industries</a><br />has becomeaspects ofTelevision" +
+	"sufficientbasketballboth sidescontinuingan article<img alt=\"" +
+	"adventureshis mothermanchesterprinciplesparticularcommentary" +
+	"effects ofdecided to\"><strong>publishersJournal ofdifficulty" +
+	"facilitateacceptablestyle.css\"\tfunction innovation>Copyright" +
+	"situationswould havebusinessesDictionarystatementsoften used" +
+	"persistentin Januarycomprising</title>\n\tdiplomaticcontaining" +
+	"performingextensionsmay not beconcept of onclick=\"It is also" +
+	"financial making theLuxembourgadditionalare calledengaged in" +
+	"\"script\");but it waselectroniconsubmit=\"\n<!-- End electrical" +
+	"officiallysuggestiontop of theunlike theAustralianOriginally" +
+	"references\n</head>\r\nrecognisedinitializelimited toAlexandria" +
+	"retirementAdventuresfour years\n\n&lt;!-- increasingdecoration" +
+	"h3 class=\"origins ofobligationregulationclassified(function(" +
+	"advantagesbeing the historians<base hrefrepeatedlywilling to" +
+	"comparabledesignatednominationfunctionalinside therevelation" +
+	"end of thes for the authorizedrefused totake placeautonomous" +
+	"compromisepolitical restauranttwo of theFebruary 2quality of" +
+	"swfobject.understandnearly allwritten byinterviews\" width=\"1" +
+	"withdrawalfloat:leftis usuallycandidatesnewspapersmysterious" +
+	"Departmentbest knownparliamentsuppressedconvenientremembered" +
+	"different systematichas led topropagandacontrolledinfluences" +
+	"ceremonialproclaimedProtectionli class=\"Scientificclass=\"no-" +
+	"trademarksmore than widespreadLiberationtook placeday of the" +
+	"as long asimprisonedAdditional\n<head>\n<mLaboratoryNovember 2" +
+	"exceptionsIndustrialvariety offloat: lefDuring theassessment" +
+	"have been deals withStatisticsoccurrence/ul></div>clearfix\">" +
+	"the publicmany yearswhich wereover time,synonymouscontent\">\n" +
+	"presumablyhis familyuserAgent.unexpectedincluding challenged" +
+	"a minorityundefined\"belongs totaken fromin Octoberposition: " +
+	"said to bereligious Federation rowspan=\"only a fewmeant that" +
+	"led to the-->\r\n<div <fieldset>Archbishop class=\"nobeing used" +
+	"approachesprivilegesnoscript>\nresults inmay be theEaster egg" +
+	"mechanismsreasonablePopulationCollectionselected\">noscript>\r" +
+	"/index.phparrival of-jssdk'));managed toincompletecasualties" +
+	"completionChristiansSeptember arithmeticproceduresmight have" +
+	"Productionit appearsPhilosophyfriendshipleading togiving the" +
+	"toward theguaranteeddocumentedcolor:#000video gamecommission" +
+	"reflectingchange theassociatedsans-serifonkeypress; padding:" +
+	"He was theunderlyingtypically , and the srcElementsuccessive" +
+	"since the should be networkingaccountinguse of thelower than" +
+	"shows that</span>\n\t\tcomplaintscontinuousquantitiesastronomer" +
+	"he did notdue to itsapplied toan averageefforts tothe future" +
+	"attempt toTherefore,capabilityRepublicanwas formedElectronic" +
+	"kilometerschallengespublishingthe formerindigenousdirections" +
+	"subsidiaryconspiracydetails ofand in theaffordablesubstances" +
+	"reason forconventionitemtype=\"absolutelysupposedlyremained a" +
+	"attractivetravellingseparatelyfocuses onelementaryapplicable" +
+	"found thatstylesheetmanuscriptstands for no-repeat(sometimes" +
+	"Commercialin Americaundertakenquarter ofan examplepersonally" +
+	"index.php?</button>\npercentagebest-knowncreating a\" dir=\"ltr" +
+	"Lieutenant\n<div id=\"they wouldability ofmade up ofnoted that" +
+	"clear thatargue thatto anotherchildren'spurpose offormulated" +
+	"based uponthe regionsubject ofpassengerspossession.\n\nIn the " +
+	"Before theafterwardscurrently across thescientificcommunity." +
+	"capitalismin Germanyright-wingthe systemSociety ofpolitician" +
+	"direction:went on toremoval of New York apartmentsindication" +
+	"during theunless thehistoricalhad been adefinitiveingredient" +
+	"attendanceCenter forprominencereadyStatestrategiesbut in the" +
+	"as part ofconstituteclaim thatlaboratorycompatiblefailure of" +
+	", such as began withusing the to providefeature offrom which" +
+	"/\" class=\"geologicalseveral ofdeliberateimportant holds that" +
+	"ing&quot; valign=topthe Germanoutside ofnegotiatedhis career" +
+	"separationid=\"searchwas calledthe fourthrecreationother than" +
+	"preventionwhile the education,connectingaccuratelywere built" +
+	"was killedagreementsmuch more Due to thewidth: 100some other" +
+	"Kingdom ofthe entirefamous forto connectobjectivesthe French" +
+	"people andfeatured\">is said tostructuralreferendummost often" +
+	"a separate->\n<div id Official worldwide.aria-labelthe planet" +
+	"and it wasd\" value=\"looking atbeneficialare in themonitoring" +
+	"reportedlythe modernworking onallowed towhere the innovative" +
+	"</a></div>soundtracksearchFormtend to beinput id=\"opening of" +
+	"restrictedadopted byaddressingtheologianmethods ofvariant of" +
+	"Christian very largeautomotiveby far therange frompursuit of" +
+	"follow thebrought toin Englandagree thataccused ofcomes from" +
+	"preventingdiv style=his or hertremendousfreedom ofconcerning" +
+	"0 1em 1em;Basketball/style.cssan earliereven after/\" title=\"" +
+	".com/indextaking thepittsburghcontent\">\r<script>(fturned out" +
+	"having the</span>\r\n occasionalbecause itstarted tophysically" +
+	"></div>\n  created byCurrently, bgcolor=\"tabindex=\"disastrous" +
+	"Analytics also has a><div id=\"</style>\n<called forsinger and" +
+	".src = \"//violationsthis pointconstantlyis locatedrecordings" +
+	"d from thenederlandsportuguêsעבריתفارسیdesarrollo" +
+	"comentarioeducaciónseptiembreregistradodirecciónubicación" +
+	"publicidadrespuestasresultadosimportantereservadosartículos" +
+	"diferentessiguientesrepúblicasituaciónministerioprivacidad" +
+	"directorioformaciónpoblaciónpresidentecontenidosaccesorios" +
+	"technoratipersonalescategoríaespecialesdisponibleactualidad" +
+	"referenciavalladolidbibliotecarelacionescalendariopolíticas" +
+	"anterioresdocumentosnaturalezamaterialesdiferenciaeconómica" +
+	"transporterodríguezparticiparencuentrandiscusiónestructura" +
+	"fundaciónfrecuentespermanentetotalmenteможнобудет" +
+	"можетвремятакжечтобыболееочень" +
+	"этогокогдапослевсегосайтечерез" +
+	"могутсайтажизнимеждубудутПоиск" +
+	"здесьвидеосвязинужносвоейлюдей" +
+	"порномногодетейсвоихправатакой" +
+	"местоимеетжизньоднойлучшеперед" +
+	"частичастьработновыхправособой" +
+	"потомменеечисленовыеуслугоколо" +
+	"назадтакоетогдапочтиПослетакие" +
+	"новыйстоиттакихсразуСанктфорум" +
+	"Когдакнигислованашейнайтисвоим" +
+	"связьлюбойчастосредиКромеФорум" +
+	"рынкесталипоисктысячмесяццентр" +
+	"трудасамыхрынкаНовыйчасовместа" +
+	"фильммартастранместетекстнаших" +
+	"минутимениимеютномергородсамом" +
+	"этомуконцесвоемкакойАрхивمنتدى" +
+	"إرسالرسالةالعامكتبهابرامجاليوم" +
+	"الصورجديدةالعضوإضافةالقسمالعاب" +
+	"تحميلملفاتملتقىتعديلالشعرأخبار" +
+	"تطويرعليكمإرفاقطلباتاللغةترتيب" +
+	"الناسالشيخمنتديالعربالقصصافلام" +
+	"عليهاتحديثاللهمالعملمكتبةيمكنك" +
+	"الطفلفيديوإدارةتاريخالصحةتسجيل" +
+	"الوقتعندمامدينةتصميمأرشيفالذين" +
+	"عربيةبوابةألعابالسفرمشاكلتعالى" +
+	"الأولالسنةجامعةالصحفالدينكلمات" +
+	"الخاصالملفأعضاءكتابةالخيررسائل" +
+	"القلبالأدبمقاطعمراسلمنطقةالكتب" +
+	"الرجلاشتركالقدميعطيك" +
+
+	// 11-byte words
+	"sByTagName(.jpg\" alt=\"1px solid #.gif\" alt=\"transparent" +
+	"informationapplication\" onclick=\"establishedadvertising" +
+	".png\" alt=\"environmentperformanceappropriate&amp;mdash;" +
+	"immediately</strong></rather thantemperaturedevelopment" +
+	"competitionplaceholdervisibility:copyright\">0\" height=\"" +
+	"even thoughreplacementdestinationCorporation<ul class=\"" +
+	"AssociationindividualsperspectivesetTimeout(url(http://" +
+	"mathematicsmargin-top:eventually description) no-repeat" +
+	"collections.JPG|thumb|participate/head><bodyfloat:left;" +
+	"<li class=\"hundreds of\n\nHowever, compositionclear:both;" +
+	"cooperationwithin the label for=\"border-top:New Zealand" +
+	"recommendedphotographyinteresting&lt;sup&gt;controversy" +
+	"Netherlandsalternativemaxlength=\"switzerlandDevelopment" +
+	"essentially\n\nAlthough </textarea>thunderbirdrepresented" +
+	"&amp;ndash;speculationcommunitieslegislationelectronics" +
+	"\n\t<div id=\"illustratedengineeringterritoriesauthorities" +
+	"distributed6\" height=\"sans-serif;capable of disappeared" +
+	"interactivelooking forit would beAfghanistanwas created" +
+	"Math.floor(surroundingcan also beobservationmaintenance" +
+	"encountered<h2 class=\"more recentit has beeninvasion of" +
+	").getTime()fundamentalDespite the\"><div id=\"inspiration" +
+	"examinationpreparationexplanation<input id=\"</a></span>" +
+	"versions ofinstrumentsbefore the  = 'http://Description" +
+	"relatively .substring(each of theexperimentsinfluential" +
+	"integrationmany peopledue to the combinationdo not have" +
+	"Middle East<noscript><copyright\" perhaps theinstitution" +
+	"in Decemberarrangementmost famouspersonalitycreation of" +
+	"limitationsexclusivelysovereignty-content\">\n<td class=\"" +
+	"undergroundparallel todoctrine ofoccupied byterminology" +
+	"Renaissancea number ofsupport forexplorationrecognition" +
+	"predecessor<img src=\"/<h1 class=\"publicationmay also be" +
+	"specialized</fieldset>progressivemillions ofstates that" +
+	"enforcementaround the one another.parentNodeagriculture" +
+	"Alternativeresearcherstowards theMost of themany other " +
+	"(especially<td width=\";width:100%independent<h3 class=\"" +
+	" onchange=\").addClass(interactionOne of the daughter of" +
+	"accessoriesbranches of\r\n<div id=\"the largestdeclaration" +
+	"regulationsInformationtranslationdocumentaryin order to" +
+	"\">\n<head>\n<\" height=\"1across the orientation);</script>" +
+	"implementedcan be seenthere was ademonstratecontainer\">" +
+	"connectionsthe Britishwas written!important;px; margin-" +
+	"followed byability to complicatedduring the immigration" +
+	"also called<h4 class=\"distinctionreplaced bygovernments" +
+	"location ofin Novemberwhether the</p>\n</div>acquisition" +
+	"called the persecutiondesignation{font-size:appeared in" +
+	"investigateexperiencedmost likelywidely useddiscussions" +
+	"presence of (document.extensivelyIt has beenit does not" +
+	"contrary toinhabitantsimprovementscholarshipconsumption" +
+	"instructionfor exampleone or morepx; paddingthe current" +
+	"a series ofare usuallyrole in thepreviously derivatives" +
+	"evidence ofexperiencescolorschemestated thatcertificate" +
+	"</a></div>\n selected=\"high schoolresponse tocomfortable" +
+	"adoption ofthree yearsthe countryin Februaryso that the" +
+	"people who provided by<param nameaffected byin terms of" +
+	"appointmentISO-8859-1\"was born inhistorical regarded as" +
+	"measurementis based on and other : function(significant" +
+	"celebrationtransmitted/js/jquery.is known astheoretical" +
+	" tabindex=\"it could be<noscript>\nhaving been\r\n<head>\r\n<" +
+	" &quot;The compilationhe had beenproduced byphilosopher" +
+	"constructedintended toamong othercompared toto say that" +
+	"Engineeringa differentreferred todifferencesbelief that" +
+	"photographsidentifyingHistory of Republic ofnecessarily" +
+	"probabilitytechnicallyleaving thespectacularfraction of" +
+	"electricityhead of therestaurantspartnershipemphasis on" +
+	"most recentshare with saying thatfilled withdesigned to" +
+	"it is often\"></iframe>as follows:merged withthrough the" +
+	"commercial pointed outopportunityview of therequirement" +
+	"division ofprogramminghe receivedsetInterval\"></span></" +
+	"in New Yorkadditional compression\n\n<div id=\"incorporate" +
+	";</script><attachEventbecame the \" target=\"_carried out" +
+	"Some of thescience andthe time ofContainer\">maintaining" +
+	"ChristopherMuch of thewritings of\" height=\"2size of the" +
+	"version of mixture of between theExamples ofeducational" +
+	"competitive onsubmit=\"director ofdistinctive/DTD XHTML " +
+	"relating totendency toprovince ofwhich woulddespite the" +
+	"scientific legislature.innerHTML allegationsAgriculture" +
+	"was used inapproach tointelligentyears later,sans-serif" +
+	"determiningPerformanceappearances, which is foundations" +
+	"abbreviatedhigher thans from the individual composed of" +
+	"supposed toclaims thatattributionfont-size:1elements of" +
+	"Historical his brotherat the timeanniversarygoverned by" +
+	"related to ultimately innovationsit is stillcan only be" +
+	"definitionstoGMTStringA number ofimg class=\"Eventually," +
+	"was changedoccurred inneighboringdistinguishwhen he was" +
+	"introducingterrestrialMany of theargues thatan American" +
+	"conquest ofwidespread were killedscreen and In order to" +
+	"expected todescendantsare locatedlegislativegenerations" +
+	" backgroundmost peopleyears afterthere is nothe highest" +
+	"frequently they do notargued thatshowed thatpredominant" +
+	"theologicalby the timeconsideringshort-lived</span></a>" +
+	"can be usedvery littleone of the had alreadyinterpreted" +
+	"communicatefeatures ofgovernment,</noscript>entered the" +
+	"\" height=\"3Independentpopulationslarge-scale. Although " +
+	"used in thedestructionpossibilitystarting intwo or more" +
+	"expressionssubordinatelarger thanhistory and</option>\r\n" +
+	"Continentaleliminatingwill not bepractice ofin front of" +
+	"site of theensure thatto create amississippipotentially" +
+	"outstandingbetter thanwhat is nowsituated inmeta name=\"" +
+	"TraditionalsuggestionsTranslationthe form ofatmospheric" +
+	"ideologicalenterprisescalculatingeast of theremnants of" +
+	"pluginspage/index.php?remained intransformedHe was also" +
+	"was alreadystatisticalin favor ofMinistry ofmovement of" +
+	"formulationis required<link rel=\"This is the <a href=\"/" +
+	"popularizedinvolved inare used toand severalmade by the" +
+	"seems to belikely thatPalestiniannamed afterit had been" +
+	"most commonto refer tobut this isconsecutivetemporarily" +
+	"In general,conventionstakes placesubdivisionterritorial" +
+	"operationalpermanentlywas largelyoutbreak ofin the past" +
+	"following a xmlns:og=\"><a class=\"class=\"textConversion " +
+	"may be usedmanufactureafter beingclearfix\">\nquestion of" +
+	"was electedto become abecause of some peopleinspired by" +
+	"successful a time whenmore commonamongst thean official" +
+	"width:100%;technology,was adoptedto keep thesettlements" +
+	"live birthsindex.html\"Connecticutassigned to&amp;times;" +
+	"account foralign=rightthe companyalways beenreturned to" +
+	"involvementBecause thethis period\" name=\"q\" confined to" +
+	"a result ofvalue=\"\" />is actuallyEnvironment\r\n</head>\r\n" +
+	"Conversely,>\n<div id=\"0\" width=\"1is probablyhave become" +
+	"controllingthe problemcitizens ofpoliticiansreached the" +
+	"as early as:none; over<table cellvalidity ofdirectly to" +
+	"onmousedownwhere it iswhen it wasmembers of relation to" +
+	"accommodatealong with In the latethe Englishdelicious\">" +
+	"this is notthe presentif they areand finallya matter of" +
+	"\r\n\t</div>\r\n\r\n</script>faster thanmajority ofafter which" +
+	"comparativeto maintainimprove theawarded theer\" class=\"" +
+	"frameborderrestorationin the sameanalysis oftheir first" +
+	"During the continentalsequence offunction(){font-size: " +
+	"work on the</script>\n<begins withjavascript:constituent" +
+	"was foundedequilibriumassume thatis given byneeds to be" +
+	"coordinatesthe variousare part ofonly in thesections of" +
+	"is a commontheories ofdiscoveriesassociationedge of the" +
+	"strength ofposition inpresent-dayuniversallyto form the" +
+	"but insteadcorporationattached tois commonlyreasons for" +
+	" &quot;the can be madewas able towhich meansbut did not" +
+	"onMouseOveras possibleoperated bycoming fromthe primary" +
+	"addition offor severaltransferreda period ofare able to" +
+	"however, itshould havemuch larger\n\t</script>adopted the" +
+	"property ofdirected byeffectivelywas broughtchildren of" +
+	"Programminglonger thanmanuscriptswar againstby means of" +
+	"and most ofsimilar to proprietaryoriginatingprestigious" +
+	"grammaticalexperience.to make theIt was alsois found in" +
+	"competitorsin the U.S.replace thebrought thecalculation" +
+	"fall of thethe generalpracticallyin honor ofreleased in" +
+	"residentialand some ofking of thereaction to1st Earl of" +
+	"culture andprincipally</title>\n  they can beback to the" +
+	"some of hisexposure toare similarform of theaddFavorite" +
+	"citizenshippart in thepeople within practiceto continue" +
+	"&amp;minus;approved by the first allowed theand for the" +
+	"functioningplaying thesolution toheight=\"0\" in his book" +
+	"more than afollows thecreated thepresence in&nbsp;</td>" +
+	"nationalistthe idea ofa characterwere forced class=\"btn" +
+	"days of thefeatured inshowing theinterest inin place of" +
+	"turn of thethe head ofLord of thepoliticallyhas its own" +
+	"Educationalapproval ofsome of theeach other,behavior of" +
+	"and becauseand anotherappeared onrecorded inblack&quot;" +
+	"may includethe world'scan lead torefers to aborder=\"0\" " +
+	"government winning theresulted in while the Washington," +
+	"the subjectcity in the></div>\r\n\t\treflect theto complete" +
+	"became moreradioactiverejected bywithout anyhis father," +
+	"which couldcopy of theto indicatea politicalaccounts of" +
+	"constitutesworked wither</a></li>of his lifeaccompanied" +
+	"clientWidthprevent theLegislativedifferentlytogether in" +
+	"has severalfor anothertext of thefounded thee with the " +
+	"is used forchanged theusually theplace wherewhereas the" +
+	"> <a href=\"\"><a href=\"themselves,although hethat can be" +
+	"traditionalrole of theas a resultremoveChilddesigned by" +
+	"west of theSome peopleproduction,side of thenewsletters" +
+	"used by thedown to theaccepted bylive in theattempts to" +
+	"outside thefrequenciesHowever, inprogrammersat least in" +
+	"approximatealthough itwas part ofand variousGovernor of" +
+	"the articleturned into><a href=\"/the economyis the most" +
+	"most widelywould laterand perhapsrise to theoccurs when" +
+	"under whichconditions.the westerntheory thatis produced" +
+	"the city ofin which heseen in thethe centralbuilding of" +
+	"many of hisarea of theis the onlymost of themany of the" +
+	"the WesternThere is noextended toStatisticalcolspan=2 |" +
+	"short storypossible totopologicalcritical ofreported to" +
+	"a Christiandecision tois equal toproblems ofThis can be" +
+	"merchandisefor most ofno evidenceeditions ofelements in" +
+	"&quot;. Thecom/images/which makesthe processremains the" +
+	"literature,is a memberthe popularthe ancientproblems in" +
+	"time of thedefeated bybody of thea few yearsmuch of the" +
+	"the work ofCalifornia,served as agovernment.concepts of" +
+	"movement in\t\t<div id=\"it\" value=\"language ofas they are" +
+	"produced inis that theexplain thediv></div>\nHowever the" +
+	"lead to the\t<a href=\"/was grantedpeople havecontinually" +
+	"was seen asand relatedthe role ofproposed byof the best" +
+	"each other.Constantinepeople fromdialects ofto revision" +
+	"was renameda source ofthe initiallaunched inprovide the" +
+	"to the westwhere thereand similarbetween twois also the" +
+	"English andconditions,that it wasentitled tothemselves." +
+	"quantity ofransparencythe same asto join thecountry and" +
+	"this is theThis led toa statementcontrast tolastIndexOf" +
+	"through hisis designedthe term isis providedprotect the" +
+	"ng</a></li>The currentthe site ofsubstantialexperience," +
+	"in the Westthey shouldslovenčinacomentariosuniversidad" +
+	"condicionesactividadesexperienciatecnologíaproducción" +
+	"puntuaciónaplicacióncontraseñacategoríasregistrarse" +
+	"profesionaltratamientoregístratesecretaríaprincipales" +
+	"protecciónimportantesimportanciaposibilidadinteresante" +
+	"crecimientonecesidadessuscribirseasociacióndisponibles" +
+	"evaluaciónestudiantesresponsableresoluciónguadalajara" +
+	"registradosoportunidadcomercialesfotografíaautoridades" +
+	"ingenieríatelevisióncompetenciaoperacionesestablecido" +
+	"simplementeactualmentenavegaciónconformidad" +
+
+	// 12-byte words
+	"line-height:font-family:\" : \"http://applicationslink\" href=\"" +
+	"specifically//<![CDATA[\nOrganizationdistribution0px; height:" +
+	"relationshipdevice-width<div class=\"<label for=\"registration" +
+	"</noscript>\n/index.html\"window.open( !important;application/" +
+	"independence//www.googleorganizationautocompleterequirements" +
+	"conservative<form name=\"intellectualmargin-left:18th century" +
+	"an importantinstitutionsabbreviation<img class=\"organisation" +
+	"civilization19th centuryarchitectureincorporated20th century" +
+	"-container\">most notably/></a></div>notification'undefined')" +
+	"Furthermore,believe thatinnerHTML = prior to thedramatically" +
+	"referring tonegotiationsheadquartersSouth Africaunsuccessful" +
+	"PennsylvaniaAs a result,<html lang=\"&lt;/sup&gt;dealing with" +
+	"philadelphiahistorically);</script>\npadding-top:experimental" +
+	"getAttributeinstructionstechnologiespart of the =function(){" +
+	"subscriptionl.dtd\">\r\n<htgeographicalConstitution', function(" +
+	"supported byagriculturalconstructionpublicationsfont-size: 1" +
+	"a variety of<div style=\"Encyclopediaiframe src=\"demonstrated" +
+	"accomplisheduniversitiesDemographics);</script><dedicated to" +
+	"knowledge ofsatisfactionparticularly</div></div>English (US)" +
+	"appendChild(transmissions. However, intelligence\" tabindex=\"" +
+	"float:right;Commonwealthranging fromin which theat least one" +
+	"reproductionencyclopedia;font-size:1jurisdictionat that time" +
+	"\"><a class=\"In addition,description+conversationcontact with" +
+	"is generallyr\" content=\"representing&lt;math&gt;presentation" +
+	"occasionally<img width=\"navigation\">compensationchampionship" +
+	"media=\"all\" violation ofreference toreturn true;Strict//EN\" " +
+	"transactionsinterventionverificationInformation difficulties" +
+	"Championshipcapabilities<![endif]-->}\n</script>\nChristianity" +
+	"for example,Professionalrestrictionssuggest thatwas released" +
+	"(such as theremoveClass(unemploymentthe Americanstructure of" +
+	"/index.html published inspan class=\"\"><a href=\"/introduction" +
+	"belonging toclaimed thatconsequences<meta name=\"Guide to the" +
+	"overwhelmingagainst the concentrated,\n.nontouch observations" +
+	"</a>\n</div>\nf (document.border: 1px {font-size:1treatment of" +
+	"0\" height=\"1modificationIndependencedivided intogreater than" +
+	"achievementsestablishingJavaScript\" neverthelesssignificance" +
+	"Broadcasting>&nbsp;</td>container\">\nsuch as the influence of" +
+	"a particularsrc='http://navigation\" half of the substantial " +
+	"&nbsp;</div>advantage ofdiscovery offundamental metropolitan" +
+	"the opposite\" xml:lang=\"deliberatelyalign=centerevolution of" +
+	"preservationimprovementsbeginning inJesus ChristPublications" +
+	"disagreementtext-align:r, function()similaritiesbody></html>" +
+	"is currentlyalphabeticalis sometimestype=\"image/many of the " +
+	"flow:hidden;available indescribe theexistence ofall over the" +
+	"the Internet\t<ul class=\"installationneighborhoodarmed forces" +
+	"reducing thecontinues toNonetheless,temperatures\n\t\t<a href=\"" +
+	"close to theexamples of is about the(see below).\" id=\"search" +
+	"professionalis availablethe official\t\t</script>\n\n\t\t<div id=\"" +
+	"accelerationthrough the Hall of Famedescriptionstranslations" +
+	"interference type='text/recent yearsin the worldvery popular" +
+	"{background:traditional some of the connected toexploitation" +
+	"emergence ofconstitutionA History ofsignificant manufactured" +
+	"expectations><noscript><can be foundbecause the has not been" +
+	"neighbouringwithout the added to the\t<li class=\"instrumental" +
+	"Soviet Unionacknowledgedwhich can bename for theattention to" +
+	"attempts to developmentsIn fact, the<li class=\"aimplications" +
+	"suitable formuch of the colonizationpresidentialcancelBubble" +
+	" Informationmost of the is describedrest of the more or less" +
+	"in SeptemberIntelligencesrc=\"http://px; height: available to" +
+	"manufacturerhuman rightslink href=\"/availabilityproportional" +
+	"outside the astronomicalhuman beingsname of the are found in" +
+	"are based onsmaller thana person whoexpansion ofarguing that" +
+	"now known asIn the earlyintermediatederived fromScandinavian" +
+	"</a></div>\r\nconsider thean estimatedthe National<div id=\"pag" +
+	"resulting incommissionedanalogous toare required/ul>\n</div>\n" +
+	"was based onand became a&nbsp;&nbsp;t\" value=\"\" was captured" +
+	"no more thanrespectivelycontinue to >\r\n<head>\r\n<were created" +
+	"more generalinformation used for theindependent the Imperial" +
+	"component ofto the northinclude the Constructionside of the " +
+	"would not befor instanceinvention ofmore complexcollectively" +
+	"background: text-align: its originalinto accountthis process" +
+	"an extensivehowever, thethey are notrejected thecriticism of" +
+	"during whichprobably thethis article(function(){It should be" +
+	"an agreementaccidentallydiffers fromArchitecturebetter known" +
+	"arrangementsinfluence onattended theidentical tosouth of the" +
+	"pass throughxml\" title=\"weight:bold;creating thedisplay:none" +
+	"replaced the<img src=\"/ihttps://www.World War IItestimonials" +
+	"found in therequired to and that thebetween the was designed" +
+	"consists of considerablypublished bythe languageConservation" +
+	"consisted ofrefer to theback to the css\" media=\"People from " +
+	"available onproved to besuggestions\"was known asvarieties of" +
+	"likely to becomprised ofsupport the hands of thecoupled with" +
+	"connect and border:none;performancesbefore beinglater became" +
+	"calculationsoften calledresidents ofmeaning that><li class=\"" +
+	"evidence forexplanationsenvironments\"></a></div>which allows" +
+	"Introductiondeveloped bya wide rangeon behalf ofvalign=\"top\"" +
+	"principle ofat the time,</noscript>\rsaid to havein the first" +
+	"while othershypotheticalphilosopherspower of thecontained in" +
+	"performed byinability towere writtenspan style=\"input name=\"" +
+	"the questionintended forrejection ofimplies thatinvented the" +
+	"the standardwas probablylink betweenprofessor ofinteractions" +
+	"changing theIndian Ocean class=\"lastworking with'http://www." +
+	"years beforeThis was therecreationalentering themeasurements" +
+	"an extremelyvalue of thestart of the\n</script>\n\nan effort to" +
+	"increase theto the southspacing=\"0\">sufficientlythe European" +
+	"converted toclearTimeoutdid not haveconsequentlyfor the next" +
+	"extension ofeconomic andalthough theare producedand with the" +
+	"insufficientgiven by thestating thatexpenditures</span></a>\n" +
+	"thought thaton the basiscellpadding=image of thereturning to" +
+	"information,separated byassassinateds\" content=\"authority of" +
+	"northwestern</div>\n<div \"></div>\r\n  consultationcommunity of" +
+	"the nationalit should beparticipants align=\"leftthe greatest" +
+	"selection ofsupernaturaldependent onis mentionedallowing the" +
+	"was inventedaccompanyinghis personalavailable atstudy of the" +
+	"on the otherexecution ofHuman Rightsterms of theassociations" +
+	"research andsucceeded bydefeated theand from thebut they are" +
+	"commander ofstate of theyears of agethe study of<ul class=\"s" +
+	"place in thewhere he was<li class=\"fthere are nowhich became" +
+	"he publishedexpressed into which thecommissionerfont-weight:" +
+	"territory ofextensions\">Roman Empireequal to theIn contrast," +
+	"however, andis typicallyand his wife(also called><ul class=\"" +
+	"effectively evolved intoseem to havewhich is thethere was no" +
+	"an excellentall of thesedescribed byIn practice,broadcasting" +
+	"charged withreflected insubjected tomilitary andto the point" +
+	"economicallysetTargetingare actuallyvictory over();</script>" +
+	"continuouslyrequired forevolutionaryan effectivenorth of the" +
+	", which was front of theor otherwisesome form ofhad not been" +
+	"generated byinformation.permitted toincludes thedevelopment," +
+	"entered intothe previousconsistentlyare known asthe field of" +
+	"this type ofgiven to thethe title ofcontains theinstances of" +
+	"in the northdue to theirare designedcorporationswas that the" +
+	"one of thesemore popularsucceeded insupport fromin different" +
+	"dominated bydesigned forownership ofand possiblystandardized" +
+	"responseTextwas intendedreceived theassumed thatareas of the" +
+	"primarily inthe basis ofin the senseaccounts fordestroyed by" +
+	"at least twowas declaredcould not beSecretary ofappear to be" +
+	"margin-top:1/^\\s+|\\s+$/ge){throw e};the start oftwo separate" +
+	"language andwho had beenoperation ofdeath of thereal numbers" +
+	"\t<link rel=\"provided thethe story ofcompetitionsenglish (UK)" +
+	"english (US)МонголСрпскисрпскисрпско" +
+	"لعربية正體中文简体中文繁体中文有限公司" +
+	"人民政府阿里巴巴社会主义操作系统政策法规" +
+	"informaciónherramientaselectrónicodescripciónclasificados" +
+	"conocimientopublicaciónrelacionadasinformáticarelacionados" +
+	"departamentotrabajadoresdirectamenteayuntamientomercadoLibre" +
+	"contáctenoshabitacionescumplimientorestaurantesdisposición" +
+	"consecuenciaelectrónicaaplicacionesdesconectadoinstalación" +
+	"realizaciónutilizaciónenciclopediaenfermedadesinstrumentos" +
+	"experienciasinstituciónparticularessubcategoriaтолько" +
+	"Россииработыбольшепростоможете" +
+	"другихслучаесейчасвсегдаРоссия" +
+	"Москведругиегородавопросданных" +
+	"должныименноМосквырублейМосква" +
+	"страныничегоработедолженуслуги" +
+	"теперьОднакопотомуработуапреля" +
+	"вообщеодногосвоегостатьидругой" +
+	"форумехорошопротивссылкакаждый" +
+	"властигруппывместеработасказал" +
+	"первыйделатьденьгипериодбизнес" +
+	"основемоменткупитьдолжнарамках" +
+	"началоРаботаТолькосовсемвторой" +
+	"началасписокслужбысистемпечати" +
+	"новогопомощисайтовпочемупомощь" +
+	"должноссылкибыстроданныемногие" +
+	"проектСейчасмоделитакогоонлайн" +
+	"городеверсиястранефильмыуровня" +
+	"разныхискатьнеделюянваряменьше" +
+	"многихданнойзначитнельзяфорума" +
+	"ТеперьмесяцазащитыЛучшиеनहीं" +
+	"करनेअपनेकियाकरेंअन्य" +
+	"क्यागाइडबारेकिसीदिया" +
+	"पहलेसिंहभारतअपनीवाले" +
+	"सेवाकरतेमेरेहोनेसकते" +
+	"बहुतसाइटहोगाजानेमिनट" +
+	"करताकरनाउनकेयहाँसबसे" +
+	"भाषाआपकेलियेशुरूइसके" +
+	"घंटेमेरीसकतामेरालेकर" +
+	"अधिकअपनासमाजमुझेकारण" +
+	"होताकड़ीयहांहोटलशब्द" +
+	"लियाजीवनजाताकैसेआपका" +
+	"वालीदेनेपूरीपानीउसके" +
+	"होगीबैठकआपकीवर्षगांव" +
+	"आपकोजिलाजानासहमतहमें" +
+	"उनकीयाहूदर्जसूचीपसंद" +
+	"सवालहोनाहोतीजैसेवापस" +
+	"जनतानेताजारीघायलजिले" +
+	"नीचेजांचपत्रगूगलजाते" +
+	"बाहरआपनेवाहनइसकासुबह" +
+	"रहनेइससेसहितबड़ेघटना" +
+	"तलाशपांचश्रीबड़ीहोते" +
+	"साईटशायदसकतीजातीवाला" +
+	"हजारपटनारखनेसड़कमिला" +
+	"उसकीकेवललगताखानाअर्थ" +
+	"जहांदेखापहलीनियमबिना" +
+	"बैंककहींकहनादेताहमले" +
+	"काफीजबकितुरतमांगवहीं" +
+	"रोज़मिलीआरोपसेनायादव" +
+	"लेनेखाताकरीबउनकाजवाब" +
+	"पूराबड़ासौदाशेयरकिये" +
+	"कहांअकसरबनाएवहांस्थल" +
+	"मिलेलेखकविषयक्रंसमूह" +
+	"थानाتستطيعمشاركةبواسطةالصفحة" +
+	"مواضيعالخاصةالمزيدالعامةالكاتب" +
+	"الردودبرنامجالدولةالعالمالموقع" +
+	"العربيالسريعالجوالالذهابالحياة" +
+	"الحقوقالكريمالعراقمحفوظةالثاني" +
+	"مشاهدةالمرأةالقرآنالشبابالحوار" +
+	"الجديدالأسرةالعلوممجموعةالرحمن" +
+	"النقاطفلسطينالكويتالدنيابركاته" +
+	"الرياضتحياتيبتوقيتالأولىالبريد" +
+	"الكلامالرابطالشخصيسياراتالثالث" +
+	"الصلاةالحديثالزوارالخليجالجميع" +
+	"العامهالجمالالساعةمشاهدهالرئيس" +
+	"الدخولالفنيةالكتابالدوريالدروس" +
+	"استغرقتصاميمالبناتالعظيم" +
+
+	// 13-byte words
+	"entertainmentunderstanding = function().jpg\" width=\"" +
+	"configuration.png\" width=\"<body class=\"Math.random()" +
+	"contemporary United Statescircumstances.appendChild(" +
+	"organizations<span class=\"\"><img src=\"/distinguished" +
+	"thousands of communicationclear\"></div>investigation" +
+	"favicon.ico\" margin-right:based on the Massachusetts" +
+	"table border=internationalalso known aspronunciation" +
+	"background:#fpadding-left:For example, miscellaneous" +
+	"&lt;/math&gt;psychologicalin particularearch\" type=\"" +
+	"form method=\"as opposed toSupreme Courtoccasionally " +
+	"Additionally,North Americapx;backgroundopportunities" +
+	"Entertainment.toLowerCase(manufacturingprofessional " +
+	"combined withFor instance,consisting of\" maxlength=\"" +
+	"return false;consciousnessMediterraneanextraordinary" +
+	"assassinationsubsequently button type=\"the number of" +
+	"the original comprehensiverefers to the</ul>\n</div>\n" +
+	"philosophicallocation.hrefwas publishedSan Francisco" +
+	"(function(){\n<div id=\"mainsophisticatedmathematical " +
+	"/head>\r\n<bodysuggests thatdocumentationconcentration" +
+	"relationshipsmay have been(for example,This article " +
+	"in some casesparts of the definition ofGreat Britain" +
+	" cellpadding=equivalent toplaceholder=\"; font-size: " +
+	"justificationbelieved thatsuffered fromattempted to " +
+	"leader of thecript\" src=\"/(function() {are available" +
+	"\n\t<link rel=\" src='http://interested inconventional " +
+	"\" alt=\"\" /></are generallyhas also beenmost popular " +
+	"correspondingcredited withtyle=\"border:</a></span></" +
+	".gif\" width=\"<iframe src=\"table class=\"inline-block;" +
+	"according to together withapproximatelyparliamentary" +
+	"more and moredisplay:none;traditionallypredominantly" +
+	"&nbsp;|&nbsp;&nbsp;</span> cellspacing=<input name=\"" +
+	"or\" content=\"controversialproperty=\"og:/x-shockwave-" +
+	"demonstrationsurrounded byNevertheless,was the first" +
+	"considerable Although the collaborationshould not be" +
+	"proportion of<span style=\"known as the shortly after" +
+	"for instance,described as /head>\n<body starting with" +
+	"increasingly the fact thatdiscussion ofmiddle of the" +
+	"an individualdifficult to point of viewhomosexuality" +
+	"acceptance of</span></div>manufacturersorigin of the" +
+	"commonly usedimportance ofdenominationsbackground: #" +
+	"length of thedeterminationa significant\" border=\"0\">" +
+	"revolutionaryprinciples ofis consideredwas developed" +
+	"Indo-Europeanvulnerable toproponents ofare sometimes" +
+	"closer to theNew York City name=\"searchattributed to" +
+	"course of themathematicianby the end ofat the end of" +
+	"\" border=\"0\" technological.removeClass(branch of the" +
+	"evidence that![endif]-->\r\nInstitute of into a single" +
+	"respectively.and thereforeproperties ofis located in" +
+	"some of whichThere is alsocontinued to appearance of" +
+	" &amp;ndash; describes theconsiderationauthor of the" +
+	"independentlyequipped withdoes not have</a><a href=\"" +
+	"confused with<link href=\"/at the age ofappear in the" +
+	"These includeregardless ofcould be used style=&quot;" +
+	"several timesrepresent thebody>\n</html>thought to be" +
+	"population ofpossibilitiespercentage ofaccess to the" +
+	"an attempt toproduction ofjquery/jquerytwo different" +
+	"belong to theestablishmentreplacing thedescription\" " +
+	"determine theavailable forAccording to wide range of" +
+	"\t<div class=\"more commonlyorganisationsfunctionality" +
+	"was completed &amp;mdash; participationthe character" +
+	"an additionalappears to befact that thean example of" +
+	"significantlyonmouseover=\"because they async = true;" +
+	"problems withseems to havethe result of src=\"http://" +
+	"familiar withpossession offunction () {took place in" +
+	"and sometimessubstantially<span></span>is often used" +
+	"in an attemptgreat deal ofEnvironmentalsuccessfully " +
+	"virtually all20th century,professionalsnecessary to " +
+	"determined bycompatibilitybecause it isDictionary of" +
+	"modificationsThe followingmay refer to:Consequently," +
+	"Internationalalthough somethat would beworld's first" +
+	"classified asbottom of the(particularlyalign=\"left\" " +
+	"most commonlybasis for thefoundation ofcontributions" +
+	"popularity ofcenter of theto reduce thejurisdictions" +
+	"approximation onmouseout=\"New Testamentcollection of" +
+	"</span></a></in the Unitedfilm director-strict.dtd\">" +
+	"has been usedreturn to thealthough thischange in the" +
+	"several otherbut there areunprecedentedis similar to" +
+	"especially inweight: bold;is called thecomputational" +
+	"indicate thatrestricted to\t<meta name=\"are typically" +
+	"conflict withHowever, the An example ofcompared with" +
+	"quantities ofrather than aconstellationnecessary for" +
+	"reported thatspecificationpolitical and&nbsp;&nbsp;<" +
+	"references tothe same yearGovernment ofgeneration of" +
+	"have not beenseveral yearscommitment to\t\t<ul class=\"" +
+	"visualization19th century,practitionersthat he would" +
+	"and continuedoccupation ofis defined ascentre of the" +
+	"the amount of><div style=\"equivalent ofdifferentiate" +
+	"brought aboutmargin-left: automaticallythought of as" +
+	"Some of these\n<div class=\"input class=\"replaced with" +
+	"is one of theeducation andinfluenced byreputation as" +
+	"\n<meta name=\"accommodation</div>\n</div>large part of" +
+	"Institute forthe so-called against the In this case," +
+	"was appointedclaimed to beHowever, thisDepartment of" +
+	"the remainingeffect on theparticularly deal with the" +
+	"\n<div style=\"almost alwaysare currentlyexpression of" +
+	"philosophy offor more thancivilizationson the island" +
+	"selectedIndexcan result in\" value=\"\" />the structure" +
+	" /></a></div>Many of thesecaused by theof the United" +
+	"span class=\"mcan be tracedis related tobecame one of" +
+	"is frequentlyliving in thetheoreticallyFollowing the" +
+	"Revolutionarygovernment inis determinedthe political" +
+	"introduced insufficient todescription\">short stories" +
+	"separation ofas to whetherknown for itswas initially" +
+	"display:blockis an examplethe principalconsists of a" +
+	"recognized as/body></html>a substantialreconstructed" +
+	"head of stateresistance toundergraduateThere are two" +
+	"gravitationalare describedintentionallyserved as the" +
+	"class=\"headeropposition tofundamentallydominated the" +
+	"and the otheralliance withwas forced torespectively," +
+	"and politicalin support ofpeople in the20th century." +
+	"and publishedloadChartbeatto understandmember states" +
+	"environmentalfirst half ofcountries andarchitectural" +
+	"be consideredcharacterizedclearIntervalauthoritative" +
+	"Federation ofwas succeededand there area consequence" +
+	"the Presidentalso includedfree softwaresuccession of" +
+	"developed thewas destroyedaway from the;\n</script>\n<" +
+	"although theyfollowed by amore powerfulresulted in a" +
+	"University ofHowever, manythe presidentHowever, some" +
+	"is thought tountil the endwas announcedare important" +
+	"also includes><input type=the center of DO NOT ALTER" +
+	"used to referthemes/?sort=that had beenthe basis for" +
+	"has developedin the summercomparativelydescribed the" +
+	"such as thosethe resultingis impossiblevarious other" +
+	"South Africanhave the sameeffectivenessin which case" +
+	"; text-align:structure and; background:regarding the" +
+	"supported theis also knownstyle=\"marginincluding the" +
+	"bahasa Melayunorsk bokmålnorsk nynorskslovenščina" +
+	"internacionalcalificacióncomunicaciónconstrucción" +
+
+	// 14-byte words
+	"\"><div class=\"disambiguationDomainName', 'administration" +
+	"simultaneouslytransportationInternational margin-bottom:" +
+	"responsibility<![endif]-->\n</><meta name=\"implementation" +
+	"infrastructurerepresentationborder-bottom:</head>\n<body>" +
+	"=http%3A%2F%2F<form method=\"method=\"post\" /favicon.ico\" " +
+	"});\n</script>\n.setAttribute(Administration= new Array();" +
+	"<![endif]-->\r\ndisplay:block;Unfortunately,\">&nbsp;</div>" +
+	"/favicon.ico\">='stylesheet' identification, for example," +
+	"<li><a href=\"/an alternativeas a result ofpt\"></script>\n" +
+	"type=\"submit\" \n(function() {recommendationform action=\"/" +
+	"transformationreconstruction.style.display According to " +
+	"hidden\" name=\"along with thedocument.body.approximately " +
+	"Communicationspost\" action=\"meaning &quot;--<![endif]-->" +
+	"Prime Ministercharacteristic</a> <a class=the history of" +
+	" onmouseover=\"the governmenthref=\"https://was originally" +
+	"was introducedclassificationrepresentativeare considered" +
+	"<![endif]-->\n\ndepends on theUniversity of in contrast to" +
+	" placeholder=\"in the case ofinternational constitutional" +
+	"style=\"border-: function() {Because of the-strict.dtd\">\n" +
+	"<table class=\"accompanied byaccount of the<script src=\"/" +
+	"nature of the the people in in addition tos); js.id = id" +
+	"\" width=\"100%\"regarding the Roman Catholican independent" +
+	"following the .gif\" width=\"1the following discrimination" +
+	"archaeologicalprime minister.js\"></script>combination of" +
+	" marginwidth=\"createElement(w.attachEvent(</a></td></tr>" +
+	"src=\"https://aIn particular, align=\"left\" Czech Republic" +
+	"United Kingdomcorrespondenceconcluded that.html\" title=\"" +
+	"(function () {comes from theapplication of<span class=\"s" +
+	"believed to beement('script'</a>\n</li>\n<livery different" +
+	"><span class=\"option value=\"(also known as\t<li><a href=\"" +
+	"><input name=\"separated fromreferred to as valign=\"top\">" +
+	"founder of theattempting to carbon dioxide\n\n<div class=\"" +
+	"class=\"search-/body>\n</html>opportunity tocommunications" +
+	"</head>\r\n<body style=\"width:Tiếng Việtchanges in the" +
+	"border-color:#0\" border=\"0\" </span></div><was discovered" +
+	"\" type=\"text\" );\n</script>\n\nDepartment of ecclesiastical" +
+	"there has beenresulting from</body></html>has never been" +
+	"the first timein response toautomatically </div>\n\n<div i" +
+	"was consideredpercent of the\" /></a></div>collection of " +
+	"descended fromsection of theaccept-charsetto be confused" +
+	"member of the padding-right:translation ofinterpretation" +
+	" href='http://whether or notThere are alsothere are many" +
+	"a small numberother parts ofimpossible to  class=\"button" +
+	"located in the. However, theand eventuallyAt the end of " +
+	"because of itsrepresents the<form action=\" method=\"post\"" +
+	"it is possiblemore likely toan increase inhave also been" +
+	"corresponds toannounced thatalign=\"right\">many countries" +
+	"for many yearsearliest knownbecause it waspt\"></script>\r" +
+	" valign=\"top\" inhabitants offollowing year\r\n<div class=\"" +
+	"million peoplecontroversial concerning theargue that the" +
+	"government anda reference totransferred todescribing the" +
+	" style=\"color:although therebest known forsubmit\" name=\"" +
+	"multiplicationmore than one recognition ofCouncil of the" +
+	"edition of the  <meta name=\"Entertainment away from the " +
+	";margin-right:at the time ofinvestigationsconnected with" +
+	"and many otheralthough it isbeginning with <span class=\"" +
+	"descendants of<span class=\"i align=\"right\"</head>\n<body " +
+	"aspects of thehas since beenEuropean Unionreminiscent of" +
+	"more difficultVice Presidentcomposition ofpassed through" +
+	"more importantfont-size:11pxexplanation ofthe concept of" +
+	"written in the\t<span class=\"is one of the resemblance to" +
+	"on the groundswhich containsincluding the defined by the" +
+	"publication ofmeans that theoutside of thesupport of the" +
+	"<input class=\"<span class=\"t(Math.random()most prominent" +
+	"description ofConstantinoplewere published<div class=\"se" +
+	"appears in the1\" height=\"1\" most importantwhich includes" +
+	"which had beendestruction ofthe population\n\t<div class=\"" +
+	"possibility ofsometimes usedappear to havesuccess of the" +
+	"intended to bepresent in thestyle=\"clear:b\r\n</script>\r\n<" +
+	"was founded ininterview with_id\" content=\"capital of the" +
+	"\r\n<link rel=\"srelease of thepoint out thatxMLHttpRequest" +
+	"and subsequentsecond largestvery importantspecifications" +
+	"surface of theapplied to theforeign policy_setDomainName" +
+	"established inis believed toIn addition tomeaning of the" +
+	"is named afterto protect theis representedDeclaration of" +
+	"more efficientClassificationother forms ofhe returned to" +
+	"<span class=\"cperformance of(function() {\rif and only if" +
+	"regions of theleading to therelations withUnited Nations" +
+	"style=\"height:other than theype\" content=\"Association of" +
+	"\n</head>\n<bodylocated on theis referred to(including the" +
+	"concentrationsthe individualamong the mostthan any other" +
+	"/>\n<link rel=\" return false;the purpose ofthe ability to" +
+	";color:#fff}\n.\n<span class=\"the subject ofdefinitions of" +
+	">\r\n<link rel=\"claim that thehave developed<table width=\"" +
+	"celebration ofFollowing the to distinguish<span class=\"b" +
+	"takes place inunder the namenoted that the><![endif]-->\n" +
+	"style=\"margin-instead of theintroduced thethe process of" +
+	"increasing thedifferences inestimated thatespecially the" +
+	"/div><div id=\"was eventuallythroughout histhe difference" +
+	"something thatspan></span></significantly ></script>\r\n\r\n" +
+	"environmental to prevent thehave been usedespecially for" +
+	"understand theis essentiallywere the firstis the largest" +
+	"have been made\" src=\"http://interpreted assecond half of" +
+	"crolling=\"no\" is composed ofII, Holy Romanis expected to" +
+	"have their owndefined as thetraditionally have different" +
+	"are often usedto ensure thatagreement withcontaining the" +
+	"are frequentlyinformation onexample is theresulting in a" +
+	"</a></li></ul> class=\"footerand especiallytype=\"button\" " +
+	"</span></span>which included>\n<meta name=\"considered the" +
+	"carried out byHowever, it isbecame part ofin relation to" +
+	"popular in thethe capital ofwas officiallywhich has been" +
+	"the History ofalternative todifferent fromto support the" +
+	"suggested thatin the process  <div class=\"the foundation" +
+	"because of hisconcerned withthe universityopposed to the" +
+	"the context of<span class=\"ptext\" name=\"q\"\t\t<div class=\"" +
+	"the scientificrepresented bymathematicianselected by the" +
+	"that have been><div class=\"cdiv id=\"headerin particular," +
+	"converted into);\n</script>\n<philosophical srpskohrvatski" +
+	"tiếng ViệtРусскийрусскийinvestigación" +
+	"participaciónкоторыеобластикоторый" +
+	"человексистемыНовостикоторых" +
+	"областьвременикотораясегодня" +
+	"скачатьновостиУкраинывопросы" +
+	"которойсделатьпомощьюсредств" +
+	"образомстороныучастиетечение" +
+	"Главнаяисториисистемарешения" +
+	"Скачатьпоэтомуследуетсказать" +
+	"товаровконечнорешениекоторое" +
+	"органовкоторомРекламаالمنتدى" +
+	"منتدياتالموضوعالبرامجالمواقع" +
+	"الرسائلمشاركاتالأعضاءالرياضة" +
+	"التصميمالاعضاءالنتائجالألعاب" +
+	"التسجيلالأقسامالضغطاتالفيديو" +
+	"الترحيبالجديدةالتعليمالأخبار" +
+	"الافلامالأفلامالتاريخالتقنية" +
+	"الالعابالخواطرالمجتمعالديكور" +
+	"السياحةعبداللهالتربيةالروابط" +
+	"الأدبيةالاخبارالمتحدةالاغاني" +
+
+	// 15-byte words
+	"cursor:pointer;</title>\n<meta \" href=\"http://\"><span class=\"" +
+	"members of the window.locationvertical-align:/a> | <a href=\"" +
+	"<!doctype html>media=\"screen\" <option value=\"favicon.ico\" />" +
+	"\n\t\t<div class=\"characteristics\" method=\"get\" /body>\n</html>\n" +
+	"shortcut icon\" document.write(padding-bottom:representatives" +
+	"submit\" value=\"align=\"center\" throughout the science fiction" +
+	"\n  <div class=\"submit\" class=\"one of the most valign=\"top\"><" +
+	"was established);\r\n</script>\r\nreturn false;\">).style.display" +
+	"because of the document.cookie<form action=\"/}body{margin:0;" +
+	"Encyclopedia ofversion of the .createElement(name\" content=\"" +
+	"</div>\n</div>\n\nadministrative </body>\n</html>history of the " +
+	"\"><input type=\"portion of the as part of the &nbsp;<a href=\"" +
+	"other countries\">\n<div class=\"</span></span><In other words," +
+	"display: block;control of the introduction of/>\n<meta name=\"" +
+	"as well as the in recent years\r\n\t<div class=\"</div>\n\t</div>\n" +
+	"inspired by thethe end of the compatible withbecame known as" +
+	" style=\"margin:.js\"></script>< International there have been" +
+	"German language style=\"color:#Communist Partyconsistent with" +
+	"border=\"0\" cell marginheight=\"the majority of\" align=\"center" +
+	"related to the many different Orthodox Churchsimilar to the " +
+	"/>\n<link rel=\"swas one of the until his death})();\n</script>" +
+	"other languagescompared to theportions of thethe Netherlands" +
+	"the most commonbackground:url(argued that thescrolling=\"no\" " +
+	"included in theNorth American the name of theinterpretations" +
+	"the traditionaldevelopment of frequently useda collection of" +
+	"very similar tosurrounding theexample of thisalign=\"center\">" +
+	"would have beenimage_caption =attached to thesuggesting that" +
+	"in the form of involved in theis derived fromnamed after the" +
+	"Introduction torestrictions on style=\"width: can be used to " +
+	"the creation ofmost important information andresulted in the" +
+	"collapse of theThis means thatelements of thewas replaced by" +
+	"analysis of theinspiration forregarded as themost successful" +
+	"known as &quot;a comprehensiveHistory of the were considered" +
+	"returned to theare referred toUnsourced image>\n\t<div class=\"" +
+	"consists of thestopPropagationinterest in theavailability of" +
+	"appears to haveelectromagneticenableServices(function of the" +
+	"It is important</script></div>function(){var relative to the" +
+	"as a result of the position ofFor example, in method=\"post\" " +
+	"was followed by&amp;mdash; thethe applicationjs\"></script>\r\n" +
+	"ul></div></div>after the deathwith respect tostyle=\"padding:" +
+	"is particularlydisplay:inline; type=\"submit\" is divided into" +
+	"中文 (简体)responsabilidadadministracióninternacionales" +
+	"correspondienteउपयोगपूर्वहमारे" +
+	"लोगोंचुनावलेकिनसरकार" +
+	"पुलिसखोजेंचाहिएभेजें" +
+	"शामिलहमारीजागरणबनाने" +
+	"कुमारब्लॉगमालिकमहिला" +
+	"पृष्ठबढ़तेभाजपाक्लिक" +
+	"ट्रेनखिलाफदौरानमामले" +
+	"मतदानबाजारविकासक्यों" +
+	"चाहतेपहुँचबतायासंवाद" +
+	"देखनेपिछलेविशेषराज्य" +
+	"उत्तरमुंबईदोनोंउपकरण" +
+	"पढ़ेंस्थितफिल्ममुख्य" +
+	"अच्छाछूटतीसंगीतजाएगा" +
+	"विभागघण्टेदूसरेदिनों" +
+	"हत्यासेक्सगांधीविश्व" +
+	"रातेंदैट्सनक्शासामने" +
+	"अदालतबिजलीपुरूषहिंदी" +
+	"मित्रकवितारुपयेस्थान" +
+	"करोड़मुक्तयोजनाकृपया" +
+	"पोस्टघरेलूकार्यविचार" +
+	"सूचनामूल्यदेखेंहमेशा" +
+	"स्कूलमैंनेतैयारजिसके" +
+
+	// 16-byte words
+	"rss+xml\" title=\"-type\" content=\"title\" content=\"at the same time" +
+	".js\"></script>\n<\" method=\"post\" </span></a></li>vertical-align:t" +
+	"/jquery.min.js\">.click(function( style=\"padding-})();\n</script>\n" +
+	"</span><a href=\"<a href=\"http://); return false;text-decoration:" +
+	" scrolling=\"no\" border-collapse:associated with Bahasa Indonesia" +
+	"English language<text xml:space=.gif\" border=\"0\"</body>\n</html>\n" +
+	"overflow:hidden;img src=\"http://addEventListenerresponsible for " +
+	"s.js\"></script>\n/favicon.ico\" />operating system\" style=\"width:1" +
+	"target=\"_blank\">State Universitytext-align:left;\ndocument.write(" +
+	", including the around the world);\r\n</script>\r\n<\" style=\"height:" +
+	";overflow:hiddenmore informationan internationala member of the " +
+	"one of the firstcan be found in </div>\n\t\t</div>\ndisplay: none;\">" +
+	"\" />\n<link rel=\"\n  (function() {the 15th century.preventDefault(" +
+	"large number of Byzantine Empire.jpg|thumb|left|vast majority of" +
+	"majority of the  align=\"center\">University Pressdominated by the" +
+	"Second World Wardistribution of style=\"position:the rest of the " +
+	"characterized by rel=\"nofollow\">derives from therather than the " +
+	"a combination ofstyle=\"width:100English-speakingcomputer science" +
+	"border=\"0\" alt=\"the existence ofDemocratic Party\" style=\"margin-" +
+	"For this reason,.js\"></script>\n\tsByTagName(s)[0]js\"></script>\r\n<" +
+	".js\"></script>\r\nlink rel=\"icon\" ' alt='' class='formation of the" +
+	"versions of the </a></div></div>/page>\n  <page>\n<div class=\"cont" +
+	"became the firstbahasa Indonesiaenglish (simple)Ελληνικά" +
+	"хрватскикомпанииявляетсяДобавить" +
+	"человекаразвитияИнтернетОтветить" +
+	"напримеринтернеткоторогостраницы" +
+	"качествеусловияхпроблемыполучить" +
+	"являютсянаиболеекомпаниявнимание" +
+	"средстваالمواضيعالرئيسيةالانتقال" +
+	"مشاركاتكالسياراتالمكتوبةالسعودية" +
+	"احصائياتالعالميةالصوتياتالانترنت" +
+	"التصاميمالإسلاميالمشاركةالمرئيات" +
+
+	// 17-byte words
+	"robots\" content=\"<div id=\"footer\">the United States" +
+	"<img src=\"http://.jpg|right|thumb|.js\"></script>\r\n<" +
+	"location.protocolframeborder=\"0\" s\" />\n<meta name=\"" +
+	"</a></div></div><font-weight:bold;&quot; and &quot;" +
+	"depending on the margin:0;padding:\" rel=\"nofollow\" " +
+	"President of the twentieth centuryevision>\n  </page" +
+	"Internet Explorera.async = true;\r\ninformation about" +
+	"<div id=\"header\">\" action=\"http://<a href=\"https://" +
+	"<div id=\"content\"</div>\r\n</div>\r\n<derived from the " +
+	"<img src='http://according to the \n</body>\n</html>\n" +
+	"style=\"font-size:script language=\"Arial, Helvetica," +
+	"</a><span class=\"</script><script political parties" +
+	"td></tr></table><href=\"http://www.interpretation of" +
+	"rel=\"stylesheet\" document.write('<charset=\"utf-8\">\n" +
+	"beginning of the revealed that thetelevision series" +
+	"\" rel=\"nofollow\"> target=\"_blank\">claiming that the" +
+	"http%3A%2F%2Fwww.manifestations ofPrime Minister of" +
+	"influenced by theclass=\"clearfix\">/div>\r\n</div>\r\n\r\n" +
+	"three-dimensionalChurch of Englandof North Carolina" +
+	"square kilometres.addEventListenerdistinct from the" +
+	"commonly known asPhonetic Alphabetdeclared that the" +
+	"controlled by theBenjamin Franklinrole-playing game" +
+	"the University ofin Western Europepersonal computer" +
+	"Project Gutenbergregardless of thehas been proposed" +
+	"together with the></li><li class=\"in some countries" +
+	"min.js\"></script>of the populationofficial language" +
+	"<img src=\"images/identified by thenatural resources" +
+	"classification ofcan be consideredquantum mechanics" +
+	"Nevertheless, themillion years ago</body>\r\n</html>\r" +
+	"Ελληνικά\ntake advantage ofand, according to" +
+	"attributed to theMicrosoft Windowsthe first century" +
+	"under the controldiv class=\"headershortly after the" +
+	"notable exceptiontens of thousandsseveral different" +
+	"around the world.reaching militaryisolated from the" +
+	"opposition to thethe Old TestamentAfrican Americans" +
+	"inserted into theseparate from themetropolitan area" +
+	"makes it possibleacknowledged thatarguably the most" +
+	"type=\"text/css\">\nthe InternationalAccording to the " +
+	"pe=\"text/css\" />\ncoincide with thetwo-thirds of the" +
+	"During this time,during the periodannounced that he" +
+	"the internationaland more recentlybelieved that the" +
+	"consciousness andformerly known assurrounded by the" +
+	"first appeared inoccasionally used" +
+
+	// 18-byte words
+	"position:absolute;\" target=\"_blank\" position:relative;" +
+	"text-align:center;jax/libs/jquery/1.background-color:#" +
+	"type=\"application/anguage\" content=\"<meta http-equiv=\"" +
+	"Privacy Policy</a>e(\"%3Cscript src='\" target=\"_blank\">" +
+	"On the other hand,.jpg|thumb|right|2</div><div class=\"" +
+	"<div style=\"float:nineteenth century</body>\r\n</html>\r\n" +
+	"<img src=\"http://s;text-align:centerfont-weight: bold;" +
+	" According to the difference between\" frameborder=\"0\" " +
+	"\" style=\"position:link href=\"http://html4/loose.dtd\">\n" +
+	"during this period</td></tr></table>closely related to" +
+	"for the first time;font-weight:bold;input type=\"text\" " +
+	"<span style=\"font-onreadystatechange\t<div class=\"clear" +
+	"document.location. For example, the a wide variety of " +
+	"<!DOCTYPE html>\r\n<&nbsp;&nbsp;&nbsp;\"><a href=\"http://" +
+	"style=\"float:left;concerned with the=http%3A%2F%2Fwww." +
+	"in popular culturetype=\"text/css\" />it is possible to " +
+	"Harvard Universitytylesheet\" href=\"/the main character" +
+	"Oxford University  name=\"keywords\" cstyle=\"text-align:" +
+	"the United Kingdomfederal government<div style=\"margin" +
+	" depending on the description of the<div class=\"header" +
+	".min.js\"></script>destruction of theslightly different" +
+	"in accordance withtelecommunicationsindicates that the" +
+	"shortly thereafterespecially in the European countries" +
+	"However, there aresrc=\"http://staticsuggested that the" +
+	"\" src=\"http://www.a large number of Telecommunications" +
+	"\" rel=\"nofollow\" tHoly Roman Emperoralmost exclusively" +
+	"\" border=\"0\" alt=\"Secretary of Stateculminating in the" +
+	"CIA World Factbookthe most importantanniversary of the" +
+	"style=\"background-<li><em><a href=\"/the Atlantic Ocean" +
+	"strictly speaking,shortly before thedifferent types of" +
+	"the Ottoman Empire><img src=\"http://An Introduction to" +
+	"consequence of thedeparture from theConfederate States" +
+	"indigenous peoplesProceedings of theinformation on the" +
+	"theories have beeninvolvement in thedivided into three" +
+	"adjacent countriesis responsible fordissolution of the" +
+	"collaboration withwidely regarded ashis contemporaries" +
+	"founding member ofDominican Republicgenerally accepted" +
+	"the possibility ofare also availableunder construction" +
+	"restoration of thethe general publicis almost entirely" +
+	"passes through thehas been suggestedcomputer and video" +
+	"Germanic languages according to the different from the" +
+	"shortly afterwardshref=\"https://www.recent development" +
+	"Board of Directors<div class=\"search| <a href=\"http://" +
+	"In particular, theMultiple footnotesor other substance" +
+	"thousands of yearstranslation of the</div>\r\n</div>\r\n\r\n" +
+	"<a href=\"index.phpwas established inmin.js\"></script>\n" +
+	"participate in thea strong influencestyle=\"margin-top:" +
+	"represented by thegraduated from theTraditionally, the" +
+	"Element(\"script\");However, since the/div>\n</div>\n<div " +
+	"left; margin-left:protection against0; vertical-align:" +
+	"Unfortunately, thetype=\"image/x-icon/div>\n<div class=\"" +
+	" class=\"clearfix\"><div class=\"footer\t\t</div>\n\t\t</div>\n" +
+	"the motion pictureБългарскибългарски" +
+	"Федерациинесколькосообщение" +
+	"сообщенияпрограммыОтправить" +
+	"бесплатноматериалыпозволяет" +
+	"последниеразличныхпродукции" +
+	"программаполностьюнаходится" +
+	"избранноенаселенияизменения" +
+	"категорииАлександрद्वारा" +
+	"मैनुअलप्रदानभारतीय" +
+	"अनुदेशहिन्दीइंडिया" +
+	"दिल्लीअधिकारवीडियो" +
+	"चिट्ठेसमाचारजंक्शन" +
+	"दुनियाप्रयोगअनुसार" +
+	"ऑनलाइनपार्टीशर्तों" +
+	"लोकसभाफ़्लैशशर्तें" +
+	"प्रदेशप्लेयरकेंद्र" +
+	"स्थितिउत्पादउन्हें" +
+	"चिट्ठायात्राज्यादा" +
+	"पुरानेजोड़ेंअनुवाद" +
+	"श्रेणीशिक्षासरकारी" +
+	"संग्रहपरिणामब्रांड" +
+	"बच्चोंउपलब्धमंत्री" +
+	"संपर्कउम्मीदमाध्यम" +
+	"सहायताशब्दोंमीडिया" +
+	"आईपीएलमोबाइलसंख्या" +
+	"आपरेशनअनुबंधबाज़ार" +
+	"नवीनतमप्रमुखप्रश्न" +
+	"परिवारनुकसानसमर्थन" +
+	"आयोजितसोमवारالمشاركات" +
+	"المنتدياتالكمبيوترالمشاهدات" +
+	"عددالزوارعددالردودالإسلامية" +
+	"الفوتوشوبالمسابقاتالمعلومات" +
+	"المسلسلاتالجرافيكسالاسلامية" +
+	"الاتصالات" +
+
+	// 19-byte words
+	"keywords\" content=\"w3.org/1999/xhtml\"><a target=\"_blank\" " +
+	"text/html; charset=\" target=\"_blank\"><table cellpadding=\"" +
+	"autocomplete=\"off\" text-align: center;to last version by " +
+	"background-color: #\" href=\"http://www./div></div><div id=" +
+	"<a href=\"#\" class=\"\"><img src=\"http://cript\" src=\"http://" +
+	"\n<script language=\"//EN\" \"http://www.wencodeURIComponent(" +
+	"\" href=\"javascript:<div class=\"contentdocument.write('<sc" +
+	"position: absolute;script src=\"http:// style=\"margin-top:" +
+	".min.js\"></script>\n</div>\n<div class=\"w3.org/1999/xhtml\" " +
+	"\n\r\n</body>\r\n</html>distinction between/\" target=\"_blank\">" +
+	"<link href=\"http://encoding=\"utf-8\"?>\nw.addEventListener?" +
+	"action=\"http://www.icon\" href=\"http:// style=\"background:" +
+	"type=\"text/css\" />\nmeta property=\"og:t<input type=\"text\" " +
+	" style=\"text-align:the development of tylesheet\" type=\"te" +
+	"html; charset=utf-8is considered to betable width=\"100%\" " +
+	"In addition to the contributed to the differences between" +
+	"development of the It is important to </script>\n\n<script " +
+	" style=\"font-size:1></span><span id=gbLibrary of Congress" +
+	"<img src=\"http://imEnglish translationAcademy of Sciences" +
+	"div style=\"display:construction of the.getElementById(id)" +
+	"in conjunction withElement('script'); <meta property=\"og:" +
+	"Български\n type=\"text\" name=\">Privacy Policy</a>" +
+	"administered by theenableSingleRequeststyle=&quot;margin:" +
+	"</div></div></div><><img src=\"http://i style=&quot;float:" +
+	"referred to as the total population ofin Washington, D.C." +
+	" style=\"background-among other things,organization of the" +
+	"participated in thethe introduction ofidentified with the" +
+	"fictional character Oxford University misunderstanding of" +
+	"There are, however,stylesheet\" href=\"/Columbia University" +
+	"expanded to includeusually referred toindicating that the" +
+	"have suggested thataffiliated with thecorrelation between" +
+	"number of different></td></tr></table>Republic of Ireland" +
+	"\n</script>\n<script under the influencecontribution to the" +
+	"Official website ofheadquarters of thecentered around the" +
+	"implications of thehave been developedFederal Republic of" +
+	"became increasinglycontinuation of theNote, however, that" +
+	"similar to that of capabilities of theaccordance with the" +
+	"participants in thefurther developmentunder the direction" +
+	"is often consideredhis younger brother</td></tr></table><" +
+	"a http-equiv=\"X-UA-physical propertiesof British Columbia" +
+	"has been criticized(with the exceptionquestions about the" +
+	"passing through the0\" cellpadding=\"0\" thousands of people" +
+	"redirects here. Forhave children under" +
+
+	// 20-byte words
+	"%3E%3C/script%3E\"));<a href=\"http://www.<li><a href=\"http://" +
+	"site_name\" content=\"text-decoration:nonestyle=\"display: none" +
+	"<meta http-equiv=\"X-new Date().getTime() type=\"image/x-icon\"" +
+	"</span><span class=\"language=\"javascriptwindow.location.href" +
+	"<a href=\"javascript:-->\r\n<script type=\"t<a href='http://www." +
+	"hortcut icon\" href=\"</div>\r\n<div class=\"<script src=\"http://" +
+	"\" rel=\"stylesheet\" t</div>\n<script type=/a> <a href=\"http://" +
+	" allowTransparency=\"X-UA-Compatible\" conrelationship between" +
+	"\n</script>\r\n<script </a></li></ul></div>associated with the " +
+	"programming language</a><a href=\"http://</a></li><li class=\"" +
+	"form action=\"http://<div style=\"display:type=\"text\" name=\"q\"" +
+	"<table width=\"100%\" background-position:\" border=\"0\" width=\"" +
+	"rel=\"shortcut icon\" h6><ul><li><a href=\"  <meta http-equiv=\"" +
+	"css\" media=\"screen\" responsible for the \" type=\"application/" +
+	"\" style=\"background-html; charset=utf-8\" allowtransparency=\"" +
+	"stylesheet\" type=\"te\r\n<meta http-equiv=\"></span><span class=" +
+	"\"0\" cellspacing=\"0\">;\n</script>\n<script sometimes called the" +
+	"does not necessarilyFor more informationat the beginning of " +
+	"<!DOCTYPE html><htmlparticularly in the type=\"hidden\" name=\"" +
+	"javascript:void(0);\"effectiveness of the autocomplete=\"off\" " +
+	"generally considered><input type=\"text\" \"></script>\r\n<script" +
+	"throughout the worldcommon misconceptionassociation with the" +
+	"</div>\n</div>\n<div cduring his lifetime,corresponding to the" +
+	"type=\"image/x-icon\" an increasing numberdiplomatic relations" +
+	"are often consideredmeta charset=\"utf-8\" <input type=\"text\" " +
+	"examples include the\"><img src=\"http://iparticipation in the" +
+	"the establishment of\n</div>\n<div class=\"&amp;nbsp;&amp;nbsp;" +
+	"to determine whetherquite different frommarked the beginning" +
+	"distance between thecontributions to theconflict between the" +
+	"widely considered towas one of the firstwith varying degrees" +
+	"have speculated that(document.getElementparticipating in the" +
+	"originally developedeta charset=\"utf-8\"> type=\"text/css\" />\n" +
+	"interchangeably withmore closely relatedsocial and political" +
+	"that would otherwiseperpendicular to thestyle type=\"text/css" +
+	"type=\"submit\" name=\"families residing indeveloping countries" +
+	"computer programmingeconomic developmentdetermination of the" +
+	"for more informationon several occasionsportuguês (Europeu)" +
+	"УкраїнськаукраїнськаРоссийской" +
+	"материаловинформацииуправления" +
+	"необходимоинформацияИнформация" +
+	"Республикиколичествоинформацию" +
+	"территориидостаточноالمتواجدون" +
+	"الاشتراكاتالاقتراحات" +
+
+	// 21-byte words
+	"html; charset=UTF-8\" setTimeout(function()display:inline-block;" +
+	"<input type=\"submit\" type = 'text/javascri<img src=\"http://www." +
+	"\" \"http://www.w3.org/shortcut icon\" href=\"\" autocomplete=\"off\" " +
+	"</a></div><div class=</a></li>\n<li class=\"css\" type=\"text/css\" " +
+	"<form action=\"http://xt/css\" href=\"http://link rel=\"alternate\" " +
+	"\r\n<script type=\"text/ onclick=\"javascript:(new Date).getTime()}" +
+	"height=\"1\" width=\"1\" People's Republic of  <a href=\"http://www." +
+	"text-decoration:underthe beginning of the </div>\n</div>\n</div>\n" +
+	"establishment of the </div></div></div></d#viewport{min-height:" +
+	"\n<script src=\"http://option><option value=often referred to as " +
+	"/option>\n<option valu<!DOCTYPE html>\n<!--[International Airport" +
+	">\n<a href=\"http://www</a><a href=\"http://wภาษาไทย" +
+	"ქართული正體中文 (繁體)निर्देश" +
+	"डाउनलोडक्षेत्रजानकारी" +
+	"संबंधितस्थापनास्वीकार" +
+	"संस्करणसामग्रीचिट्ठों" +
+	"विज्ञानअमेरिकाविभिन्न" +
+	"गाडियाँक्योंकिसुरक्षा" +
+	"पहुँचतीप्रबंधनटिप्पणी" +
+	"क्रिकेटप्रारंभप्राप्त" +
+	"मालिकोंरफ़्तारनिर्माण" +
+	"लिमिटेड" +
+
+	// 22-byte words
+	"description\" content=\"document.location.prot" +
+	".getElementsByTagName(<!DOCTYPE html>\n<html " +
+	"<meta charset=\"utf-8\">:url\" content=\"http://" +
+	".css\" rel=\"stylesheet\"style type=\"text/css\">" +
+	"type=\"text/css\" href=\"w3.org/1999/xhtml\" xml" +
+	"type=\"text/javascript\" method=\"get\" action=\"" +
+	"link rel=\"stylesheet\"  = document.getElement" +
+	"type=\"image/x-icon\" />cellpadding=\"0\" cellsp" +
+	".css\" type=\"text/css\" </a></li><li><a href=\"" +
+	"\" width=\"1\" height=\"1\"\"><a href=\"http://www." +
+	"style=\"display:none;\">alternate\" type=\"appli" +
+	"-//W3C//DTD XHTML 1.0 ellspacing=\"0\" cellpad" +
+	" type=\"hidden\" value=\"/a>&nbsp;<span role=\"s" +
+	"\n<input type=\"hidden\" language=\"JavaScript\" " +
+	" document.getElementsBg=\"0\" cellspacing=\"0\" " +
+	"ype=\"text/css\" media=\"type='text/javascript'" +
+	"with the exception of ype=\"text/css\" rel=\"st" +
+	" height=\"1\" width=\"1\" ='+encodeURIComponent(" +
+	"<link rel=\"alternate\" \nbody, tr, input, text" +
+	"meta name=\"robots\" conmethod=\"post\" action=\"" +
+	">\n<a href=\"http://www.css\" rel=\"stylesheet\" " +
+	"</div></div><div classlanguage=\"javascript\">" +
+	"aria-hidden=\"true\">·<ript\" type=\"text/javas" +
+	"l=0;})();\n(function(){background-image: url(" +
+	"/a></li><li><a href=\"h\t\t<li><a href=\"http://" +
+	"ator\" aria-hidden=\"tru> <a href=\"http://www." +
+	"language=\"javascript\" /option>\n<option value" +
+	"/div></div><div class=rator\" aria-hidden=\"tr" +
+	"e=(new Date).getTime()português (do Brasil)" +
+	"организациивозможность" +
+	"образованиярегистрации" +
+	"возможностиобязательна" +
+
+	// 23-byte words
+	"<!DOCTYPE html PUBLIC \"nt-Type\" content=\"text/" +
+	"<meta http-equiv=\"Conteransitional//EN\" \"http:" +
+	"<html xmlns=\"http://www-//W3C//DTD XHTML 1.0 T" +
+	"DTD/xhtml1-transitional//www.w3.org/TR/xhtml1/" +
+	"pe = 'text/javascript';<meta name=\"description" +
+	"parentNode.insertBefore<input type=\"hidden\" na" +
+	"js\" type=\"text/javascri(document).ready(functi" +
+	"script type=\"text/javasimage\" content=\"http://" +
+	"UA-Compatible\" content=tml; charset=utf-8\" />\n" +
+	"link rel=\"shortcut icon<link rel=\"stylesheet\" " +
+	"</script>\n<script type== document.createElemen" +
+	"<a target=\"_blank\" href= document.getElementsB" +
+	"input type=\"text\" name=a.type = 'text/javascri" +
+	"nput type=\"hidden\" namehtml; charset=utf-8\" />" +
+	"dtd\">\n<html xmlns=\"http-//W3C//DTD HTML 4.01 T" +
+	"entsByTagName('script')input type=\"hidden\" nam" +
+
+	// 24-byte words
+	"<script type=\"text/javas\" style=\"display:none;\">" +
+	"document.getElementById(=document.createElement(" +
+	"' type='text/javascript'input type=\"text\" name=\"" +
+	"d.getElementsByTagName(snical\" href=\"http://www." +
+	"C//DTD HTML 4.01 Transit<style type=\"text/css\">\n" +
+	"\n<style type=\"text/css\">ional.dtd\">\n<html xmlns=" +
+	"http-equiv=\"Content-Typeding=\"0\" cellspacing=\"0\"" +
+	"html; charset=utf-8\" />\n style=\"display:none;\"><" +
+	"<li><a href=\"http://www. type='text/javascript'>" +
+	"деятельностисоответствии" +
+	"производствабезопасности" +
+	"पुस्तिकाकांग्रेस" +
+	"उन्होंनेविधानसभा" +
+	"फिक्सिंगसुरक्षित" +
+	"कॉपीराइटविज्ञापन" +
+	"कार्रवाईसक्रियता"
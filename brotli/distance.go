@@ -0,0 +1,88 @@
+package brotli
+
+import "math"
+
+// shortCodeDistance returns the distance that short distance code (0–15)
+// stands for, given the last four distances in d, most recent first.
+func shortCodeDistance(code int, d [4]int) int {
+	switch {
+	case code < 4:
+		return d[code]
+	case code < 10:
+		return d[0] + shortCodeOffset[code-4]
+	default:
+		return d[1] + shortCodeOffset[code-10]
+	}
+}
+
+// shortDistanceCode returns the short distance code that stands for
+// distance and has the lowest estimated cost, along with that cost. If
+// none of them stand for distance, it returns -1. Distances in d that are 0
+// or less are treated as unknown.
+func (e *Encoder) shortDistanceCode(distance int, d [4]int) (code int, cost float32) {
+	code = -1
+	for c := 0; c < numDistanceShortCodes; c++ {
+		base := d[0]
+		switch {
+		case c < 4:
+			base = d[c]
+		case c >= 10:
+			base = d[1]
+		}
+		if base <= 0 || shortCodeDistance(c, d) != distance {
+			continue
+		}
+		if cc := e.costs.distance[c]; code < 0 || cc < cost {
+			code, cost = c, cc
+		}
+	}
+	return code, cost
+}
+
+// chooseDistanceParams chooses NPOSTFIX and NDIRECT for a meta-block with
+// the given explicitly-coded distances, by estimating the cost of the
+// distance codes and extra bits for each combination. Like the reference
+// implementation, it doesn't try them all: it increases NDIRECT until the
+// cost stops going down, and then moves on to the next value of NPOSTFIX,
+// starting from about half the best NDIRECT so far.
+func (e *Encoder) chooseDistanceParams(distances []int) (npostfix uint, ndirect int) {
+	if len(distances) == 0 {
+		return 0, 0
+	}
+	best := math.Inf(1)
+	msb := 0
+	for np := uint(0); np <= maxNPostfix; np++ {
+		for ; msb <= maxNDirectMSB; msb++ {
+			nd := msb << np
+			cost := e.distanceParamsCost(distances, np, nd)
+			if cost >= best {
+				break
+			}
+			best = cost
+			npostfix, ndirect = np, nd
+		}
+		if msb > 0 {
+			msb--
+		}
+		msb /= 2
+	}
+	return npostfix, ndirect
+}
+
+// distanceParamsCost estimates the number of bits needed to encode distances
+// with the given NPOSTFIX and NDIRECT.
+func (e *Encoder) distanceParamsCost(distances []int, npostfix uint, ndirect int) float64 {
+	h := &e.distanceParamsHisto
+	if h.counts == nil {
+		*h = newHistogram(maxDistanceSymbols)
+	} else {
+		h.clear()
+	}
+	var extraBits float64
+	for _, d := range distances {
+		c := getDistanceCode(d, npostfix, ndirect)
+		h.add(c.code)
+		extraBits += float64(c.nExtra)
+	}
+	return populationCost(h) + extraBits
+}
@@ -3,145 +3,346 @@ package brotli
 import "github.com/andybalholm/pack"
 
 // An Encoder implements the pack.Encoder interface, writing in Brotli format.
+// Within each meta-block, it splits the literals, commands, and distances
+// into blocks with their own prefix codes when their statistics change, and
+// it chooses a context mode for the literals and groups the contexts into
+// clusters that share a prefix code.
+//
+// Besides ordinary matches, it can encode references to the static
+// dictionary that MatchFinder finds when its StaticDictionary option is set.
 type Encoder struct {
+	// WindowBits is the base-2 logarithm of the window size, which limits
+	// how far back matches can refer. The default (if it is 0) is 22.
+	// Standard Brotli streams allow 10–24. Values from 25 to 30 select the
+	// large-window extension, which most decoders only accept if they are
+	// configured for it. The MatchFinder must not find matches beyond
+	// the window; see Limits.
+	WindowBits int
+
+	// Quality controls how much effort goes into the prefix codes, on the
+	// same scale as the reference encoder's quality levels. At 4 and
+	// above, the Encoder builds optimal length-limited Huffman codes,
+	// smooths the symbol counts so that the code lengths compress better,
+	// and stores the code lengths with run-length coding and a code length
+	// code built for them. Below 4, it uses a faster method with a fixed
+	// code length code.
+	Quality int
+
 	wroteHeader bool
 	bw          bitWriter
 	distCache   []distanceCode
+
+	// dist holds the last four distances, most recent first, as the
+	// decoder will have them at the start of the next meta-block.
+	dist [4]int
+
+	// npostfix and ndirect are the distance parameters for the current
+	// meta-block. explicit holds the distances that are coded explicitly
+	// (rather than with short codes), for choosing them.
+	npostfix            uint
+	ndirect             int
+	explicit            []int
+	distanceParamsHisto histogram
+
+	// costs are the estimates used by LiteralCost and MatchCost.
+	costs costs
+
+	// p1 and p2 are the last two bytes encoded, which determine the
+	// context of the next literal. dictContext is what they start as:
+	// the last two bytes of the dictionary, if there is one.
+	p1, p2      byte
+	dictContext [2]byte
+
+	// pos is the number of bytes in the stream so far, counting the
+	// dictionary, which determines where the static dictionary references
+	// start. dictLen is what it starts as.
+	pos     int64
+	dictLen int
+
+	literals []literal
+	mb       metaBlock
 }
 
 func (e *Encoder) Reset() {
 	e.wroteHeader = false
 	e.bw = bitWriter{}
+	e.costs.initialized = false
+	e.p1, e.p2 = e.dictContext[1], e.dictContext[0]
+	e.pos = int64(e.dictLen)
+}
+
+// SetDictionary prepares e to write streams that use dict as a raw shared
+// dictionary (also known as a prefix dictionary). The stream doesn't identify
+// the dictionary, so the decoder needs to be given the same one. It returns
+// the part of dict that fits in the window.
+func (e *Encoder) SetDictionary(dict []byte) (content []byte, err error) {
+	if max := e.Limits().MaxDistance; len(dict) > max {
+		dict = dict[len(dict)-max:]
+	}
+	e.dictContext = [2]byte{}
+	if len(dict) >= 2 {
+		e.dictContext = [2]byte{dict[len(dict)-2], dict[len(dict)-1]}
+	} else if len(dict) == 1 {
+		e.dictContext[1] = dict[0]
+	}
+	e.dictLen = len(dict)
+	if !e.wroteHeader {
+		e.p1, e.p2 = e.dictContext[1], e.dictContext[0]
+		e.pos = int64(e.dictLen)
+	}
+	return dict, nil
+}
+
+// Limits returns the limits on meta-block size, match length, and distance
+// for the streams that e writes.
+func (e *Encoder) Limits() pack.Limits {
+	return pack.Limits{
+		BlockSize:   1 << 24,
+		MinLength:   2,
+		MaxDistance: 1<<uint(e.windowBits()) - 16,
+	}
+}
+
+// windowBits returns the window size that e uses, after applying the
+// default and limits.
+func (e *Encoder) windowBits() int {
+	switch {
+	case e.WindowBits == 0:
+		return 22
+	case e.WindowBits < minWindowBits:
+		return minWindowBits
+	case e.WindowBits > largeMaxWindowBits:
+		return largeMaxWindowBits
+	}
+	return e.WindowBits
+}
+
+func (e *Encoder) largeWindow() bool {
+	return e.windowBits() > maxWindowBits
+}
+
+// distanceAlphabetSize returns the number of distance symbols in the
+// current meta-block.
+func (e *Encoder) distanceAlphabetSize() int {
+	if e.largeWindow() {
+		return distanceAlphabetSize(e.npostfix, e.ndirect, largeMaxDistanceBits)
+	}
+	return distanceAlphabetSize(e.npostfix, e.ndirect, maxDistanceBits)
+}
+
+// writeStreamHeader writes the window size.
+func (e *Encoder) writeStreamHeader() {
+	switch wbits := e.windowBits(); {
+	case wbits > maxWindowBits:
+		e.bw.writeBits(14, uint64(wbits)<<8|0x11)
+	case wbits == 16:
+		e.bw.writeBits(1, 0)
+	case wbits == 17:
+		e.bw.writeBits(7, 1)
+	case wbits > 17:
+		e.bw.writeBits(4, uint64(wbits-17)<<1|1)
+	default:
+		e.bw.writeBits(7, uint64(wbits-8)<<4|1)
+	}
+}
+
+// WindowBits returns the smallest value for Encoder.WindowBits that allows
+// matches up to maxDistance bytes back. For a MatchFinder, maxDistance is
+// its MaxDistance, or if that is 0, its MaxHistory plus the block size. If
+// the result is more than 24, the stream will use the large-window extension.
+func WindowBits(maxDistance int) int {
+	wbits := minWindowBits
+	for wbits < largeMaxWindowBits && 1<<uint(wbits)-16 < maxDistance {
+		wbits++
+	}
+	return wbits
 }
 
 func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	e.bw.dst = dst
 	if !e.wroteHeader {
-		e.bw.writeBits(4, 15)
+		e.writeStreamHeader()
 		e.wroteHeader = true
+		e.dist = [4]int{4, 11, 15, 16}
+		e.npostfix, e.ndirect = 0, 0
 	}
 
-	var literalHisto [256]uint32
-	var commandHisto [704]uint32
-	var distanceHisto [64]uint32
-	literalCount := 0
-	commandCount := 0
-	distanceCount := 0
+	if len(src) == 0 {
+		// A meta-block can't have a length of zero, so there is nothing to
+		// write except possibly the end of the stream.
+		if lastBlock {
+			e.bw.writeBits(2, 3) // islast + isempty
+			e.bw.jumpToByteBoundary()
+		}
+		return e.bw.dst
+	}
 
 	if len(e.distCache) < len(matches) {
 		e.distCache = make([]distanceCode, len(matches))
 	}
+	e.literals = e.literals[:0]
+	e.explicit = e.explicit[:0]
 
-	// first pass: build the histograms
-	pos := 0
+	// The block splitters use the parameters from the reference
+	// implementation's greedy meta-block builder.
+	literalSplitter := newBlockSplitter(256, 512, 400)
+	commandSplitter := newBlockSplitter(numCommandSymbols, 1024, 500)
 
-	// d is the ring buffer of the last 4 distances.
-	d := [4]int{-10, -10, -10, -10}
+	// first pass: split the literals and commands into blocks, collect the
+	// literals with their context, and decide which distances to code with
+	// short codes. The costs of the explicit codes are estimated with the
+	// previous meta-block's distance parameters.
+	pos := 0
+	p1, p2 := e.p1, e.p2
+	d := e.dist
 	for i, m := range matches {
-		if m.Unmatched > 0 {
-			for _, c := range src[pos : pos+m.Unmatched] {
-				literalHisto[c]++
-			}
-			literalCount += m.Unmatched
+		for _, c := range src[pos : pos+m.Unmatched] {
+			literalSplitter.add(int(c))
+			e.literals = append(e.literals, literal{c, p1, p2})
+			p1, p2 = c, p1
 		}
 
 		insertCode := getInsertLengthCode(uint(m.Unmatched))
-		copyCode := getCopyLengthCode(uint(m.Length))
+		copyCode := getCopyLengthCode(uint(copyLength(m)))
 		if m.Length == 0 {
 			// If the stream ends with unmatched bytes, we need a dummy copy length.
 			copyCode = 2
 		}
 		command := combineLengthCodes(insertCode, copyCode, false)
-		commandHisto[command]++
-		commandCount++
+		commandSplitter.add(int(command))
 
-		if command >= 128 && m.Length != 0 {
-			var distCode distanceCode
-			switch m.Distance {
-			case d[3]:
-				distCode.code = 0
-			case d[2]:
-				distCode.code = 1
-			case d[1]:
-				distCode.code = 2
-			case d[0]:
-				distCode.code = 3
-			case d[3] - 1:
-				distCode.code = 4
-			case d[3] + 1:
-				distCode.code = 5
-			case d[3] - 2:
-				distCode.code = 6
-			case d[3] + 2:
-				distCode.code = 7
-			case d[3] - 3:
-				distCode.code = 8
-			case d[3] + 3:
-				distCode.code = 9
-
-				// In my testing, codes 10–15 actually reduced the compression ratio.
-
-			default:
-				distCode = getDistanceCode(m.Distance)
+		if isStaticDictionaryMatch(m.Distance) {
+			// The distance for a static dictionary reference counts from
+			// just past the maximum backward distance, and it doesn't go
+			// into the ring buffer of recent distances.
+			_, wordID := staticDictionaryReference(m.Distance)
+			maxDistance := int64(e.Limits().MaxDistance)
+			if p := e.pos + int64(pos+m.Unmatched); p < maxDistance {
+				maxDistance = p
+			}
+			e.distCache[i] = distanceCode{code: -1}
+			e.explicit = append(e.explicit, int(maxDistance)+1+wordID)
+		} else if command >= 128 && m.Length != 0 {
+			code, cost := e.shortDistanceCode(m.Distance, d)
+			if code >= 0 {
+				explicit := getDistanceCode(m.Distance, e.npostfix, e.ndirect)
+				if cost > e.costs.distance[explicit.code]+float32(explicit.nExtra) {
+					code = -1
+				}
+			}
+			e.distCache[i] = distanceCode{code: code}
+			if code < 0 {
+				e.explicit = append(e.explicit, m.Distance)
 			}
-			e.distCache[i] = distCode
-			distanceHisto[distCode.code]++
-			distanceCount++
-			if distCode.code != 0 {
-				d[0], d[1], d[2], d[3] = d[1], d[2], d[3], m.Distance
+			if code != 0 {
+				d = [4]int{m.Distance, d[0], d[1], d[2]}
 			}
 		}
 
 		pos += m.Unmatched + m.Length
+		if m.Length >= 2 {
+			p1, p2 = src[pos-1], src[pos-2]
+		} else if m.Length == 1 {
+			p1, p2 = src[pos-1], p1
+		}
 	}
+	e.p1, e.p2 = p1, p2
+	e.pos += int64(len(src))
+	e.dist = d
+
+	// Now that the explicit distances are known, choose the distance
+	// parameters, and split the distance codes into blocks.
+	e.npostfix, e.ndirect = e.chooseDistanceParams(e.explicit)
+	numDistanceSymbols := e.distanceAlphabetSize()
+	distanceSplitter := newBlockSplitter(numDistanceSymbols, 512, 100)
+	explicit := e.explicit
+	for i, m := range matches {
+		if m.Length == 0 {
+			continue
+		}
+		if e.distCache[i].code < 0 {
+			e.distCache[i] = getDistanceCode(explicit[0], e.npostfix, e.ndirect)
+			explicit = explicit[1:]
+		}
+		distanceSplitter.add(e.distCache[i].code)
+	}
+
+	mb := &e.mb
+	mb.literalSplit, _ = literalSplitter.finish()
+	mb.commandSplit, mb.commandHistograms = commandSplitter.finish()
+	mb.distanceSplit, mb.distanceHistograms = distanceSplitter.finish()
+	mb.buildLiteralContextMap(e.literals)
 
 	storeMetaBlockHeader(uint(len(src)), false, &e.bw)
-	e.bw.writeBits(13, 0)
 
-	var literalDepths [256]byte
-	var literalBits [256]uint16
-	buildAndStoreHuffmanTreeFast(literalHisto[:], uint(literalCount), 8, literalDepths[:], literalBits[:], &e.bw)
+	var literalBlocks, commandBlocks, distanceBlocks blockEncoder
+	optimal := e.Quality >= 4
+	literalBlocks.storeSplitCode(mb.literalSplit, optimal, &e.bw)
+	commandBlocks.storeSplitCode(mb.commandSplit, optimal, &e.bw)
+	distanceBlocks.storeSplitCode(mb.distanceSplit, optimal, &e.bw)
 
-	var commandDepths [704]byte
-	var commandBits [704]uint16
-	buildAndStoreHuffmanTreeFast(commandHisto[:], uint(commandCount), 10, commandDepths[:], commandBits[:], &e.bw)
+	e.bw.writeBits(2, uint64(e.npostfix))
+	e.bw.writeBits(4, uint64(e.ndirect>>e.npostfix))
+	for i := 0; i < mb.literalSplit.numTypes; i++ {
+		e.bw.writeBits(2, uint64(mb.contextMode))
+	}
+	storeContextMap(mb.literalContextMap, len(mb.literalHistograms), optimal, &e.bw)
+
+	// Each distance block type has its own prefix code, regardless of the
+	// copy length.
+	numDistanceTypes := mb.distanceSplit.numTypes
+	distanceContextMap := make([]byte, numDistanceTypes<<2)
+	for i := range distanceContextMap {
+		distanceContextMap[i] = byte(i >> 2)
+	}
+	storeContextMap(distanceContextMap, numDistanceTypes, optimal, &e.bw)
 
-	var distanceDepths [64]byte
-	var distanceBits [64]uint16
-	buildAndStoreHuffmanTreeFast(distanceHisto[:], uint(distanceCount), 6, distanceDepths[:], distanceBits[:], &e.bw)
+	literalDepths, literalBits := storeTrees(mb.literalHistograms, 8, optimal, &e.bw)
+	commandDepths, commandBits := storeTrees(mb.commandHistograms, 10, optimal, &e.bw)
+	distanceDepths, distanceBits := storeTrees(mb.distanceHistograms, alphabetBits(numDistanceSymbols), optimal, &e.bw)
 
-	pos = 0
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+	setDepthCosts(e.costs.literal[:], mb.literalHistograms, literalDepths)
+	setDepthCosts(e.costs.command[:], mb.commandHistograms, commandDepths)
+	setDepthCosts(e.costs.distance[:numDistanceSymbols], mb.distanceHistograms, distanceDepths)
+
+	lut := getContextLUT(mb.contextMode)
+	lits := e.literals
 	for i, m := range matches {
 		insertCode := getInsertLengthCode(uint(m.Unmatched))
-		copyCode := getCopyLengthCode(uint(m.Length))
+		copyLen := copyLength(m)
+		copyCode := getCopyLengthCode(uint(copyLen))
 		if m.Length == 0 {
 			// If the stream ends with unmatched bytes, we need a dummy copy length.
 			copyCode = 2
 		}
 		command := combineLengthCodes(insertCode, copyCode, false)
-		e.bw.writeBits(uint(commandDepths[command]), uint64(commandBits[command]))
+		t := commandBlocks.nextType(&e.bw)
+		e.bw.writeBits(uint(commandDepths[t][command]), uint64(commandBits[t][command]))
 		if kInsExtra[insertCode] > 0 {
 			e.bw.writeBits(uint(kInsExtra[insertCode]), uint64(m.Unmatched)-uint64(kInsBase[insertCode]))
 		}
 		if kCopyExtra[copyCode] > 0 {
-			e.bw.writeBits(uint(kCopyExtra[copyCode]), uint64(m.Length)-uint64(kCopyBase[copyCode]))
+			e.bw.writeBits(uint(kCopyExtra[copyCode]), uint64(copyLen)-uint64(kCopyBase[copyCode]))
 		}
 
-		if m.Unmatched > 0 {
-			for _, c := range src[pos : pos+m.Unmatched] {
-				e.bw.writeBits(uint(literalDepths[c]), uint64(literalBits[c]))
-			}
+		for _, lit := range lits[:m.Unmatched] {
+			t := literalBlocks.nextType(&e.bw)
+			tree := mb.literalContextMap[t<<6+int(getContext(lit.p1, lit.p2, lut))]
+			e.bw.writeBits(uint(literalDepths[tree][lit.c]), uint64(literalBits[tree][lit.c]))
 		}
+		lits = lits[m.Unmatched:]
 
 		if command >= 128 && m.Length != 0 {
 			distCode := e.distCache[i]
-			e.bw.writeBits(uint(distanceDepths[distCode.code]), uint64(distanceBits[distCode.code]))
+			t := distanceBlocks.nextType(&e.bw)
+			e.bw.writeBits(uint(distanceDepths[t][distCode.code]), uint64(distanceBits[t][distCode.code]))
 			if distCode.nExtra > 0 {
 				e.bw.writeBits(distCode.nExtra, distCode.extraBits)
 			}
 		}
-
-		pos += m.Unmatched + m.Length
 	}
 
 	if lastBlock {
@@ -151,18 +352,53 @@ func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock
 	return e.bw.dst
 }
 
+// Flush writes an empty metadata meta-block, which pads the output to a byte
+// boundary so that all the data encoded so far can be decoded.
+func (e *Encoder) Flush(dst []byte) []byte {
+	e.bw.dst = dst
+	if !e.wroteHeader {
+		e.writeStreamHeader()
+		e.wroteHeader = true
+		e.dist = [4]int{4, 11, 15, 16}
+		e.npostfix, e.ndirect = 0, 0
+	}
+
+	e.bw.writeBits(1, 0) // islast
+	e.bw.writeBits(2, 3) // MNIBBLES = 0 (metadata)
+	e.bw.writeBits(1, 0) // reserved
+	e.bw.writeBits(2, 0) // MSKIPBYTES
+	e.bw.jumpToByteBoundary()
+	return e.bw.dst
+}
+
+// copyLength returns the copy length to encode for m: the length of the
+// dictionary word if m is a static dictionary reference, or else m.Length.
+func copyLength(m pack.Match) int {
+	if isStaticDictionaryMatch(m.Distance) {
+		wordLen, _ := staticDictionaryReference(m.Distance)
+		return wordLen
+	}
+	return m.Length
+}
+
 type distanceCode struct {
 	code      int
 	nExtra    uint
 	extraBits uint64
 }
 
-func getDistanceCode(distance int) distanceCode {
-	d := distance + 3
-	nbits := log2FloorNonZero(uint(d)) - 1
-	prefix := (d >> nbits) & 1
-	offset := (2 + prefix) << nbits
-	distcode := int(2*(nbits-1)) + prefix + 16
-	extra := d - offset
-	return distanceCode{distcode, uint(nbits), uint64(extra)}
+// getDistanceCode returns the explicit distance code and extra bits for
+// distance, with the given NPOSTFIX and NDIRECT parameters.
+func getDistanceCode(distance int, npostfix uint, ndirect int) distanceCode {
+	if distance <= ndirect {
+		return distanceCode{code: numDistanceShortCodes + distance - 1}
+	}
+	d := 1<<(npostfix+2) + distance - ndirect - 1
+	bucket := uint(log2FloorNonZero(uint(d))) - 1
+	postfix := d & (1<<npostfix - 1)
+	prefix := (d >> bucket) & 1
+	offset := (2 + prefix) << bucket
+	nbits := bucket - npostfix
+	code := numDistanceShortCodes + ndirect + (int(2*(nbits-1))+prefix)<<npostfix + postfix
+	return distanceCode{code, nbits, uint64((d - offset) >> npostfix)}
 }
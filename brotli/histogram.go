@@ -0,0 +1,239 @@
+package brotli
+
+import (
+	"math"
+	"math/bits"
+)
+
+// A histogram counts how many times each symbol occurs in a block of data.
+type histogram struct {
+	counts []uint32
+	total  uint32
+
+	// used is a bitmap of the symbols with non-zero counts, so that
+	// populationCost doesn't need to look at the others.
+	used []uint64
+
+	// cost is the estimated number of bits needed to encode the data,
+	// as calculated by populationCost. It is only kept up to date by
+	// the clustering code.
+	cost float64
+}
+
+func newHistogram(alphabetSize int) histogram {
+	return histogram{
+		counts: make([]uint32, alphabetSize),
+		used:   make([]uint64, (alphabetSize+63)/64),
+	}
+}
+
+func (h *histogram) add(symbol int) {
+	h.counts[symbol]++
+	h.total++
+	h.used[symbol>>6] |= 1 << uint(symbol&63)
+}
+
+func (h *histogram) addHistogram(other *histogram) {
+	for i, n := range other.counts {
+		h.counts[i] += n
+	}
+	h.total += other.total
+	for i, u := range other.used {
+		h.used[i] |= u
+	}
+}
+
+// subtractHistogram removes the counts in other, which must have been
+// added to h.
+func (h *histogram) subtractHistogram(other *histogram) {
+	for i, n := range other.counts {
+		h.counts[i] -= n
+	}
+	h.total -= other.total
+	for i := range h.used {
+		h.used[i] = 0
+	}
+	for i, n := range h.counts {
+		if n > 0 {
+			h.used[i>>6] |= 1 << uint(i&63)
+		}
+	}
+}
+
+// set makes h a copy of other.
+func (h *histogram) set(other *histogram) {
+	copy(h.counts, other.counts)
+	copy(h.used, other.used)
+	h.total = other.total
+	h.cost = other.cost
+}
+
+func (h *histogram) clear() {
+	for i := range h.counts {
+		h.counts[i] = 0
+	}
+	for i := range h.used {
+		h.used[i] = 0
+	}
+	h.total = 0
+	h.cost = 0
+}
+
+var log2Table = func() (t [256]float64) {
+	for i := 1; i < len(t); i++ {
+		t[i] = math.Log2(float64(i))
+	}
+	return t
+}()
+
+// fastLog2 returns log2(n), using a table for small values.
+func fastLog2(n uint32) float64 {
+	if n < uint32(len(log2Table)) {
+		return log2Table[n]
+	}
+	return math.Log2(float64(n))
+}
+
+// bitsEntropy returns the number of bits an ideal entropy coder would need
+// to encode the symbols counted in counts, but at least one bit per symbol,
+// since that is the least a prefix code can use.
+func bitsEntropy(counts []uint32) float64 {
+	var sum uint32
+	var bits float64
+	for _, n := range counts {
+		if n > 0 {
+			sum += n
+			bits -= float64(n) * fastLog2(n)
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+	bits += float64(sum) * fastLog2(sum)
+	if bits < float64(sum) {
+		bits = float64(sum)
+	}
+	return bits
+}
+
+// populationCost estimates the number of bits needed to encode the symbols
+// counted in h, including the cost of storing the prefix code.
+func populationCost(h *histogram) float64 {
+	return combinedCost(h, nil)
+}
+
+// combinedCost returns the populationCost of the sum of a and b, without
+// needing to build it. If b is nil, it is the cost of a alone.
+func combinedCost(a, b *histogram) float64 {
+	const (
+		oneSymbolCost   = 12
+		twoSymbolCost   = 20
+		threeSymbolCost = 28
+		fourSymbolCost  = 37
+	)
+	total := a.total
+	if b != nil {
+		total += b.total
+	}
+	if total == 0 {
+		return oneSymbolCost
+	}
+	count := func(i int) uint32 {
+		if b != nil {
+			return a.counts[i] + b.counts[i]
+		}
+		return a.counts[i]
+	}
+	used := func(w int) uint64 {
+		if b != nil {
+			return a.used[w] | b.used[w]
+		}
+		return a.used[w]
+	}
+
+	numUsed := 0
+	for w := range a.used {
+		numUsed += bits.OnesCount64(used(w))
+	}
+
+	// With four symbols or less, the prefix code is stored in the simple
+	// format, and the code lengths follow from the counts.
+	if numUsed <= 4 {
+		var s [4]uint32
+		n := 0
+		for w := range a.used {
+			for u := used(w); u != 0; u &= u - 1 {
+				s[n] = count(w<<6 + bits.TrailingZeros64(u))
+				n++
+			}
+		}
+		switch numUsed {
+		case 1:
+			return oneSymbolCost
+		case 2:
+			return twoSymbolCost + float64(total)
+		case 3:
+			max := s[0]
+			if s[1] > max {
+				max = s[1]
+			}
+			if s[2] > max {
+				max = s[2]
+			}
+			return threeSymbolCost + float64(2*(s[0]+s[1]+s[2])-max)
+		}
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				if s[j] > s[i] {
+					s[i], s[j] = s[j], s[i]
+				}
+			}
+		}
+		h23 := s[2] + s[3]
+		max := s[0]
+		if h23 > max {
+			max = h23
+		}
+		return fourSymbolCost + float64(3*h23+2*(s[0]+s[1])-max)
+	}
+
+	// Compute the entropy of the data, and at the same time build a
+	// histogram of the code length codes that would be needed to store the
+	// prefix code (using the repeated zero code, but not the repeated
+	// non-zero one). A run of zeros at the end is implicit, so it isn't
+	// counted.
+	var cost float64
+	var depthHisto [18]uint32
+	maxDepth := 1
+	log2Total := fastLog2(total)
+	prev := -1
+	for w := range a.used {
+		for u := used(w); u != 0; u &= u - 1 {
+			i := w<<6 + bits.TrailingZeros64(u)
+			if reps := i - prev - 1; reps >= 3 {
+				for reps -= 2; reps > 0; reps >>= 3 {
+					depthHisto[17]++
+					cost += 3
+				}
+			} else {
+				depthHisto[0] += uint32(reps)
+			}
+			prev = i
+
+			n := count(i)
+			log2p := log2Total - fastLog2(n)
+			cost += float64(n) * log2p
+			depth := int(log2p + 0.5)
+			if depth > 15 {
+				depth = 15
+			}
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+			depthHisto[depth]++
+		}
+	}
+	cost += float64(18 + 2*maxDepth)
+	cost += bitsEntropy(depthHisto[:])
+	return cost
+}
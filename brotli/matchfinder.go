@@ -60,17 +60,46 @@ type MatchFinder struct {
 	// buffer after the size exceeds MaxHistory.
 	MinHistory int
 
+	// StaticDictionary enables matches that refer to the static dictionary
+	// that is built into the Brotli format (with some of its word
+	// transforms), when there isn't a good match in the data itself. Only
+	// Encoder can encode these matches.
+	StaticDictionary bool
+
 	initialized bool
 	history     []byte
+	dictionary  []byte
 
 	// candidateCache is a place to store a reference to the candidates
 	// slice, and avoid an allocation.
 	candidateCache []int
+
+	// dictLookups and dictMatches count the searches in the static
+	// dictionary, and how many of them succeeded, so that searching can
+	// stop if it seldom finds anything.
+	dictLookups, dictMatches int
 }
 
 func (q *MatchFinder) Reset() {
 	q.Hasher.Init()
 	q.history = q.history[:0]
+	q.dictLookups, q.dictMatches = 0, 0
+
+	if len(q.dictionary) > 0 && q.MaxHistory > 0 {
+		q.history = append(q.history, q.dictionary...)
+		for i := 1; i+8 < len(q.history); i++ {
+			q.Hasher.Store(q.history, i)
+		}
+		q.initialized = true
+	}
+}
+
+// SetDictionary sets a dictionary to prime the history buffer with.
+// Since the dictionary is part of the history, it has no effect if
+// MaxHistory is 0.
+func (q *MatchFinder) SetDictionary(dict []byte) {
+	q.dictionary = append(q.dictionary[:0], dict...)
+	q.Reset()
 }
 
 // FindMatches looks for matches in src, appends them to dst, and returns dst.
@@ -147,6 +176,10 @@ func (q *MatchFinder) FindMatches(dst []pack.Match, src []byte) []pack.Match {
 
 		nextS := s
 		var match, matchLen, bestScore int
+
+		// dictDistance is the Distance of the match if it refers to the
+		// static dictionary, or 0 otherwise.
+		var dictDistance int
 		for {
 			s = nextS
 			bytesBetweenHashLookups := skip >> 5
@@ -155,7 +188,7 @@ func (q *MatchFinder) FindMatches(dst []pack.Match, src []byte) []pack.Match {
 			if nextS > sLimit {
 				goto emitRemainder
 			}
-			match, matchLen, bestScore = 0, 0, 0
+			match, matchLen, bestScore, dictDistance = 0, 0, 0, 0
 			if prevDistance != 0 {
 				// Often there is a match at the same distance back as the previous one.
 				// Check for that first.
@@ -174,6 +207,17 @@ func (q *MatchFinder) FindMatches(dst []pack.Match, src []byte) []pack.Match {
 					match, matchLen, bestScore = m, ml, score
 				}
 			}
+			if bestScore <= minScore && q.StaticDictionary && q.dictMatches >= q.dictLookups>>7 {
+				// This is the same heuristic the reference implementation uses
+				// to give up on the static dictionary when it isn't helping.
+				q.dictLookups++
+				if l, d, score := q.findStaticDictionaryMatch(src, s); score > bestScore {
+					matchLen, dictDistance, bestScore = l, d, score
+					if score > minScore {
+						q.dictMatches++
+					}
+				}
+			}
 			if bestScore > minScore {
 				break
 			}
@@ -195,11 +239,29 @@ func (q *MatchFinder) FindMatches(dst []pack.Match, src []byte) []pack.Match {
 				if score > bestScore && score > lazyThreshold {
 					base = i
 					match, matchLen, bestScore = m, ml, score
+					dictDistance = 0
 					found = true
 				}
 			}
 		}
 
+		if dictDistance != 0 {
+			dst = append(dst, pack.Match{
+				Unmatched: base - nextEmit,
+				Length:    matchLen,
+				Distance:  dictDistance,
+			})
+			s = base + matchLen
+			nextEmit = s
+			if s >= sLimit {
+				goto emitRemainder
+			}
+			for i := origBase + 1; i < s; i++ {
+				q.Hasher.Store(src, i)
+			}
+			continue
+		}
+
 		// Extend the match backward if possible.
 		for base > nextEmit && match > 0 && src[match-1] == src[base-1] {
 			match--
@@ -0,0 +1,363 @@
+package brotli
+
+// A literal is a literal byte, along with the two bytes before it, which
+// determine its context.
+type literal struct {
+	c, p1, p2 byte
+}
+
+// A metaBlock holds the block splits, literal context modeling, and
+// histograms (one per prefix code) for a meta-block.
+type metaBlock struct {
+	literalSplit  *blockSplit
+	commandSplit  *blockSplit
+	distanceSplit *blockSplit
+
+	contextMode       int
+	literalContextMap []byte
+
+	literalHistograms  []histogram
+	commandHistograms  []histogram
+	distanceHistograms []histogram
+
+	// Scratch space, reused from one meta-block to the next.
+	modeHistograms    [4][64]histogram
+	contextHistograms []histogram
+}
+
+var contextModes = [4]int{contextLSB6, contextMSB6, contextUTF8, contextSigned}
+
+// buildLiteralContextMap chooses the context mode for the literals, and
+// clusters their histograms (one for each block type and context) to build
+// the literal context map.
+func (mb *metaBlock) buildLiteralContextMap(literals []literal) {
+	// Choose the context mode that gives the lowest estimated cost with a
+	// separate prefix code for each context.
+	byMode := &mb.modeHistograms
+	for m := range byMode {
+		for c := range byMode[m] {
+			if byMode[m][c].counts == nil {
+				byMode[m][c] = newHistogram(256)
+			} else {
+				byMode[m][c].clear()
+			}
+		}
+	}
+	for _, lit := range literals {
+		for m, mode := range contextModes {
+			byMode[m][getContext(lit.p1, lit.p2, getContextLUT(mode))].add(int(lit.c))
+		}
+	}
+	bestCost := 0.0
+	for m, mode := range contextModes {
+		cost := 0.0
+		for c := range byMode[m] {
+			if byMode[m][c].total > 0 {
+				cost += populationCost(&byMode[m][c])
+			}
+		}
+		if m == 0 || cost < bestCost {
+			bestCost = cost
+			mb.contextMode = mode
+		}
+	}
+
+	// Build a histogram for each combination of block type and context.
+	numTypes := mb.literalSplit.numTypes
+	for len(mb.contextHistograms) < numTypes<<6 {
+		mb.contextHistograms = append(mb.contextHistograms, newHistogram(256))
+	}
+	histograms := mb.contextHistograms[:numTypes<<6]
+	for i := range histograms {
+		histograms[i].clear()
+	}
+	lut := getContextLUT(mb.contextMode)
+	i := 0
+	for block, length := range mb.literalSplit.lengths {
+		t := int(mb.literalSplit.types[block])
+		for _, lit := range literals[i : i+int(length)] {
+			histograms[t<<6+int(getContext(lit.p1, lit.p2, lut))].add(int(lit.c))
+		}
+		i += int(length)
+	}
+
+	// Cluster the histograms for each block type separately, and then
+	// cluster the results together, to keep the number of pairs to
+	// consider manageable.
+	mb.literalContextMap = make([]byte, numTypes<<6)
+	if numTypes == 1 {
+		clusters, symbols := clusterHistograms(histograms, maxBlockTypes)
+		for c, s := range symbols {
+			mb.literalContextMap[c] = byte(s)
+		}
+		mb.literalHistograms = clusters
+		return
+	}
+	var all []histogram
+	first := make([]int, len(histograms))
+	for t := 0; t < numTypes; t++ {
+		clusters, symbols := clusterHistograms(histograms[t<<6:(t+1)<<6], maxBlockTypes)
+		for c, s := range symbols {
+			first[t<<6+c] = len(all) + s
+		}
+		all = append(all, clusters...)
+	}
+	clusters, symbols := clusterHistograms(all, maxBlockTypes)
+	for i, s := range first {
+		mb.literalContextMap[i] = byte(symbols[s])
+	}
+	mb.literalHistograms = clusters
+}
+
+// storeVarLenUint8 writes n (0–255) in 1–11 bits.
+func storeVarLenUint8(n int, bw *bitWriter) {
+	if n == 0 {
+		bw.writeBits(1, 0)
+		return
+	}
+	nbits := uint(log2FloorNonZero(uint(n)))
+	bw.writeBits(1, 1)
+	bw.writeBits(3, uint64(nbits))
+	bw.writeBits(nbits, uint64(n)-1<<nbits)
+}
+
+// alphabetBits returns the number of bits needed to store a symbol from an
+// alphabet of size n in a simple prefix code.
+func alphabetBits(n int) uint {
+	return uint(log2FloorNonZero(uint(n-1))) + 1
+}
+
+func blockLengthCode(length uint32) int {
+	var code int
+	switch {
+	case length >= 753:
+		code = 20
+	case length >= 177:
+		code = 14
+	case length >= 41:
+		code = 7
+	}
+	for code < numBlockLenSymbols-1 && length >= kBlockLengthPrefixCode[code+1].offset {
+		code++
+	}
+	return code
+}
+
+// A blockTypeCodeCalculator keeps track of the last two block types, to
+// calculate the code for switching to the next one.
+type blockTypeCodeCalculator struct {
+	last, secondLast int
+}
+
+func (c *blockTypeCodeCalculator) next(t int) int {
+	code := t + 2
+	switch t {
+	case c.last + 1:
+		code = 1
+	case c.secondLast:
+		code = 0
+	}
+	c.secondLast, c.last = c.last, t
+	return code
+}
+
+// A blockEncoder writes the block switch commands for one category of
+// symbols.
+type blockEncoder struct {
+	split *blockSplit
+	calc  blockTypeCodeCalculator
+
+	typeDepths   [maxBlockTypes + 2]byte
+	typeBits     [maxBlockTypes + 2]uint16
+	lengthDepths [numBlockLenSymbols]byte
+	lengthBits   [numBlockLenSymbols]uint16
+
+	block     int
+	remaining uint32
+}
+
+// storeSplitCode writes the number of block types, and if there is more
+// than one, the prefix codes for block switch commands and the length of
+// the first block. The optimal parameter is passed on to storePrefixCode.
+func (b *blockEncoder) storeSplitCode(split *blockSplit, optimal bool, bw *bitWriter) {
+	b.split = split
+	b.block = 0
+	b.remaining = split.lengths[0]
+	storeVarLenUint8(split.numTypes-1, bw)
+	if split.numTypes == 1 {
+		return
+	}
+
+	var typeHisto [maxBlockTypes + 2]uint32
+	var lengthHisto [numBlockLenSymbols]uint32
+	b.calc = blockTypeCodeCalculator{last: 1, secondLast: 0}
+	for i, t := range split.types {
+		code := b.calc.next(int(t))
+		if i != 0 {
+			typeHisto[code]++
+		}
+		lengthHisto[blockLengthCode(split.lengths[i])]++
+	}
+	numTypeCodes := split.numTypes + 2
+	storePrefixCode(typeHisto[:numTypeCodes], alphabetBits(numTypeCodes), b.typeDepths[:], b.typeBits[:], optimal, bw)
+	storePrefixCode(lengthHisto[:], alphabetBits(numBlockLenSymbols), b.lengthDepths[:], b.lengthBits[:], optimal, bw)
+
+	b.calc = blockTypeCodeCalculator{last: 1, secondLast: 0}
+	b.calc.next(int(split.types[0]))
+	b.storeLength(split.lengths[0], bw)
+}
+
+func (b *blockEncoder) storeLength(length uint32, bw *bitWriter) {
+	code := blockLengthCode(length)
+	bw.writeBits(uint(b.lengthDepths[code]), uint64(b.lengthBits[code]))
+	bw.writeBits(uint(kBlockLengthPrefixCode[code].nbits), uint64(length-kBlockLengthPrefixCode[code].offset))
+}
+
+// nextType returns the block type for the next symbol, writing a block
+// switch command first if the current block is finished.
+func (b *blockEncoder) nextType(bw *bitWriter) int {
+	if b.remaining == 0 {
+		b.block++
+		t := int(b.split.types[b.block])
+		code := b.calc.next(t)
+		bw.writeBits(uint(b.typeDepths[code]), uint64(b.typeBits[code]))
+		b.remaining = b.split.lengths[b.block]
+		b.storeLength(b.remaining, bw)
+	}
+	b.remaining--
+	return int(b.split.types[b.block])
+}
+
+// storeContextMap writes the number of prefix codes, and if there is more
+// than one, the context map, using a move-to-front transform and run-length
+// coding of zeros. The optimal parameter is passed on to storePrefixCode.
+func storeContextMap(contextMap []byte, numTrees int, optimal bool, bw *bitWriter) {
+	storeVarLenUint8(numTrees-1, bw)
+	if numTrees == 1 {
+		return
+	}
+
+	var mtf [maxBlockTypes]byte
+	for i := range mtf {
+		mtf[i] = byte(i)
+	}
+	indexes := make([]byte, len(contextMap))
+	for i, v := range contextMap {
+		index := 0
+		for mtf[index] != v {
+			index++
+		}
+		indexes[i] = byte(index)
+		copy(mtf[1:index+1], mtf[:index])
+		mtf[0] = v
+	}
+
+	maxRun := 0
+	run := 0
+	for _, v := range indexes {
+		if v == 0 {
+			run++
+			if run > maxRun {
+				maxRun = run
+			}
+		} else {
+			run = 0
+		}
+	}
+	maxPrefix := 0
+	if maxRun > 0 {
+		maxPrefix = int(log2FloorNonZero(uint(maxRun)))
+	}
+	if maxPrefix > 6 {
+		maxPrefix = 6
+	}
+
+	// Each symbol is stored with its extra bits shifted left by 9.
+	var symbols []uint32
+	for i := 0; i < len(indexes); {
+		if indexes[i] != 0 {
+			symbols = append(symbols, uint32(indexes[i])+uint32(maxPrefix))
+			i++
+			continue
+		}
+		reps := 1
+		for i+reps < len(indexes) && indexes[i+reps] == 0 {
+			reps++
+		}
+		i += reps
+		for reps > 0 {
+			if reps < 2<<maxPrefix {
+				prefix := log2FloorNonZero(uint(reps))
+				symbols = append(symbols, prefix|uint32(reps-1<<prefix)<<9)
+				break
+			}
+			symbols = append(symbols, uint32(maxPrefix)|uint32(1<<maxPrefix-1)<<9)
+			reps -= 2<<maxPrefix - 1
+		}
+	}
+
+	alphabetSize := numTrees + maxPrefix
+	var histo [maxBlockTypes + 16]uint32
+	for _, s := range symbols {
+		histo[s&0x1ff]++
+	}
+	bw.writeSingleBit(maxPrefix > 0)
+	if maxPrefix > 0 {
+		bw.writeBits(4, uint64(maxPrefix)-1)
+	}
+	var depths [maxBlockTypes + 16]byte
+	var bits [maxBlockTypes + 16]uint16
+	storePrefixCode(histo[:alphabetSize], alphabetBits(alphabetSize), depths[:], bits[:], optimal, bw)
+	for _, s := range symbols {
+		sym := s & 0x1ff
+		bw.writeBits(uint(depths[sym]), uint64(bits[sym]))
+		if sym > 0 && int(sym) <= maxPrefix {
+			bw.writeBits(uint(sym), uint64(s>>9))
+		}
+	}
+	bw.writeBits(1, 1) // IMTF
+}
+
+// storePrefixCode builds a prefix code for histogram and writes it. If
+// optimal is true, it uses buildAndStoreHuffmanTree, which builds an
+// optimal length-limited code and stores the code lengths with run-length
+// coding and a code length code of their own. Otherwise it uses
+// buildAndStoreHuffmanTreeFast, which is quicker but less compact.
+func storePrefixCode(histogram []uint32, alphabetBits uint, depth []byte, bits []uint16, optimal bool, bw *bitWriter) {
+	if optimal {
+		buildAndStoreHuffmanTree(histogram, uint(len(histogram)), alphabetBits, depth, bits, bw)
+		return
+	}
+	var total uint
+	for _, n := range histogram {
+		total += uint(n)
+	}
+	buildAndStoreHuffmanTreeFast(histogram, total, alphabetBits, depth, bits, bw)
+}
+
+// storeTrees builds and writes a prefix code for each histogram, and returns
+// the code lengths and codes. If optimal is true, it smooths the counts
+// first (on a copy), so that the code lengths compress better with
+// run-length coding.
+func storeTrees(histograms []histogram, alphabetBits uint, optimal bool, bw *bitWriter) (depths [][]byte, bits [][]uint16) {
+	depths = make([][]byte, len(histograms))
+	bits = make([][]uint16, len(histograms))
+	var counts []uint32
+	var goodForRLE []byte
+	for i := range histograms {
+		h := &histograms[i]
+		depths[i] = make([]byte, len(h.counts))
+		bits[i] = make([]uint16, len(h.counts))
+		if !optimal {
+			buildAndStoreHuffmanTreeFast(h.counts, uint(h.total), alphabetBits, depths[i], bits[i], bw)
+			continue
+		}
+		counts = append(counts[:0], h.counts...)
+		if len(goodForRLE) < len(counts) {
+			goodForRLE = make([]byte, len(counts))
+		}
+		optimizeHuffmanCountsForRLE(uint(len(counts)), counts, goodForRLE)
+		buildAndStoreHuffmanTree(counts, uint(len(counts)), alphabetBits, depths[i], bits[i], bw)
+	}
+	return depths, bits
+}
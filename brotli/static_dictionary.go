@@ -0,0 +1,211 @@
+package brotli
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// Matches that refer to the static dictionary are passed from MatchFinder to
+// Encoder as pack.Match values with a Distance of
+// staticDictionaryDistance | wordLen<<18 | wordID, where wordID includes the
+// transform index as in the Brotli format. These distances are beyond any
+// that can refer to earlier data, so the two kinds of matches can't be
+// confused. The Encoder converts them to distance codes beyond the maximum
+// backward distance, which is how the format refers to the dictionary.
+const staticDictionaryDistance = 1 << 30
+
+func isStaticDictionaryMatch(distance int) bool {
+	return distance >= staticDictionaryDistance
+}
+
+// staticDictionaryReference unpacks the Distance of a static dictionary
+// match.
+func staticDictionaryReference(distance int) (wordLen, wordID int) {
+	return (distance >> 18) & 31, distance & (1<<18 - 1)
+}
+
+// A transformSuffix is one of the transforms in a staticDictionaryPrefix,
+// with the suffix that it appends.
+type transformSuffix struct {
+	suffix string
+	id     int
+}
+
+// A staticDictionaryPrefix holds the transforms that start with a particular
+// prefix, grouped by the transformation they apply to the word.
+type staticDictionaryPrefix struct {
+	prefix string
+	byType [transformUppercaseAll + 1][]transformSuffix
+}
+
+var (
+	staticDictionaryOnce sync.Once
+
+	// staticDictionaryPrefixes groups the transforms by prefix. The
+	// transforms that omit the first few bytes of a word aren't included,
+	// since the index can't find the words they apply to.
+	staticDictionaryPrefixes []staticDictionaryPrefix
+
+	// staticDictionaryBuckets is a hash table of the dictionary words,
+	// keyed on their first four bytes in lower case. The words in bucket
+	// i are staticDictionaryWords[staticDictionaryBuckets[i]:staticDictionaryBuckets[i+1]].
+	staticDictionaryBuckets [1<<staticDictionaryHashBits + 1]uint16
+	staticDictionaryWords   []staticDictionaryWord
+)
+
+const staticDictionaryHashBits = 15
+
+// A staticDictionaryWord identifies a word by its length and its index
+// among the words of that length.
+type staticDictionaryWord struct {
+	length uint8
+	index  uint16
+}
+
+func (w staticDictionaryWord) text() string {
+	start := int(dictionaryOffsetsByLength[w.length]) + int(w.index)*int(w.length)
+	return dictionaryData[start : start+int(w.length)]
+}
+
+// staticDictionaryHash hashes the first four bytes of p, ignoring the case
+// of ASCII letters.
+func staticDictionaryHash(p []byte) uint32 {
+	v := binary.LittleEndian.Uint32(p)
+	// Set the 0x20 bit of each byte that is a letter.
+	lower := v | 0x20202020
+	isLetter := uint32(0)
+	for i := uint(0); i < 32; i += 8 {
+		if c := byte(lower >> i); c >= 'a' && c <= 'z' {
+			isLetter |= 0x20 << i
+		}
+	}
+	return ((v | isLetter) * 0x1e35a7bd) >> (32 - staticDictionaryHashBits)
+}
+
+func initStaticDictionary() {
+	for id := 0; id < numTransforms; id++ {
+		t := int(kTransformsData[id*3+1])
+		if t > transformUppercaseAll {
+			continue
+		}
+		p := kPrefixSuffix[kPrefixSuffixMap[kTransformsData[id*3]]:]
+		prefix := p[1 : 1+p[0]]
+		s := kPrefixSuffix[kPrefixSuffixMap[kTransformsData[id*3+2]]:]
+		suffix := s[1 : 1+s[0]]
+
+		i := 0
+		for i < len(staticDictionaryPrefixes) && staticDictionaryPrefixes[i].prefix != prefix {
+			i++
+		}
+		if i == len(staticDictionaryPrefixes) {
+			staticDictionaryPrefixes = append(staticDictionaryPrefixes, staticDictionaryPrefix{prefix: prefix})
+		}
+		g := &staticDictionaryPrefixes[i]
+		g.byType[t] = append(g.byType[t], transformSuffix{suffix, id})
+	}
+
+	var words []staticDictionaryWord
+	var hashes []uint32
+	for length := minDictionaryWordLength; length <= maxDictionaryWordLength; length++ {
+		for i := 0; i < 1<<dictionarySizeBitsByLength[length]; i++ {
+			w := staticDictionaryWord{uint8(length), uint16(i)}
+			words = append(words, w)
+			hashes = append(hashes, staticDictionaryHash([]byte(w.text())))
+		}
+	}
+
+	// Sort the words into buckets with a counting sort.
+	for _, h := range hashes {
+		staticDictionaryBuckets[h+1]++
+	}
+	for i := 1; i < len(staticDictionaryBuckets); i++ {
+		staticDictionaryBuckets[i] += staticDictionaryBuckets[i-1]
+	}
+	staticDictionaryWords = make([]staticDictionaryWord, len(words))
+	var next [1 << staticDictionaryHashBits]uint16
+	copy(next[:], staticDictionaryBuckets[:])
+	for i, h := range hashes {
+		staticDictionaryWords[next[h]] = words[i]
+		next[h]++
+	}
+}
+
+// findStaticDictionaryMatch looks for the transformed static dictionary
+// word that best matches the data at src[pos:]. It returns the length of the
+// match, its Distance (in the form described at staticDictionaryDistance),
+// and its score. If there is no match, the score is 0.
+func (q *MatchFinder) findStaticDictionaryMatch(src []byte, pos int) (length, distance, score int) {
+	staticDictionaryOnce.Do(initStaticDictionary)
+
+	// The actual distance depends on how much data comes before src[pos],
+	// counting earlier blocks that aren't in the history buffer, but pos
+	// is the best estimate available.
+	limit := q.MaxDistance
+	if limit == 0 {
+		limit = 1<<22 - 16
+	}
+	window := pos
+	if window > limit {
+		window = limit
+	}
+
+	data := src[pos:]
+	var upper [maxDictionaryWordLength]byte
+	for gi := range staticDictionaryPrefixes {
+		g := &staticDictionaryPrefixes[gi]
+		if len(data) < len(g.prefix)+minDictionaryWordLength || string(data[:len(g.prefix)]) != g.prefix {
+			continue
+		}
+		body := data[len(g.prefix):]
+		h := staticDictionaryHash(body)
+		for _, w := range staticDictionaryWords[staticDictionaryBuckets[h]:staticDictionaryBuckets[h+1]] {
+			word := w.text()
+			for t := range g.byType {
+				if len(g.byType[t]) == 0 {
+					continue
+				}
+				n := len(word)
+				switch t {
+				case transformUppercaseFirst, transformUppercaseAll:
+					if n > len(body) {
+						continue
+					}
+					u := upper[:n]
+					copy(u, word)
+					if t == transformUppercaseFirst {
+						toUpperCase(u)
+					} else {
+						for i := 0; i < n; {
+							i += toUpperCase(u[i:])
+						}
+					}
+					if string(u) != string(body[:n]) {
+						continue
+					}
+				default:
+					n -= t
+					if n <= 0 || n > len(body) || word[:n] != string(body[:n]) {
+						continue
+					}
+				}
+
+				rest := body[n:]
+				for _, ts := range g.byType[t] {
+					if len(ts.suffix) > len(rest) || string(rest[:len(ts.suffix)]) != ts.suffix {
+						continue
+					}
+					l := len(g.prefix) + n + len(ts.suffix)
+					if q.MaxLength != 0 && l > q.MaxLength {
+						continue
+					}
+					wordID := ts.id<<dictionarySizeBitsByLength[len(word)] | int(w.index)
+					if s := backwardReferenceScore(l, window+1+wordID); s > score {
+						length, score = l, s
+						distance = staticDictionaryDistance | len(word)<<18 | wordID
+					}
+				}
+			}
+		}
+	}
+	return length, distance, score
+}
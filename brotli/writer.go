@@ -21,7 +21,7 @@ func NewWriter(w io.Writer, level int) *pack.Writer {
 		return &pack.Writer{
 			Dest:        w,
 			MatchFinder: M0{Lazy: level == 1},
-			Encoder:     &Encoder{},
+			Encoder:     &Encoder{WindowBits: WindowBits(1 << 16)},
 			BlockSize:   1 << 16,
 		}
 	}
@@ -58,14 +58,16 @@ func NewWriter(w io.Writer, level int) *pack.Writer {
 		}
 	}
 
+	mf := &MatchFinder{
+		Hasher:           h,
+		MaxHistory:       1 << 20,
+		MinHistory:       1 << 16,
+		StaticDictionary: true,
+	}
 	return &pack.Writer{
-		Dest: w,
-		MatchFinder: &MatchFinder{
-			Hasher:     h,
-			MaxHistory: 1 << 20,
-			MinHistory: 1 << 16,
-		},
-		Encoder:   &Encoder{},
-		BlockSize: 1 << 16,
+		Dest:        w,
+		MatchFinder: mf,
+		Encoder:     &Encoder{WindowBits: WindowBits(mf.MaxHistory + 1<<16), Quality: level},
+		BlockSize:   1 << 16,
 	}
 }
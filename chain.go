@@ -21,8 +21,9 @@ type HashChain struct {
 
 	table [maxTableSize]uint32
 
-	history []byte
-	chain   []uint16
+	history    []byte
+	chain      []uint16
+	dictionary []byte
 }
 
 const (
@@ -38,8 +39,10 @@ const (
 
 func (q *HashChain) Reset() {
 	q.table = [maxTableSize]uint32{}
-	q.history = q.history[:0]
 	q.chain = q.chain[:0]
+	// The dictionary is added to the hash chains along with the first
+	// block.
+	q.history = append(q.history[:0], q.dictionary...)
 }
 
 // FindMatches looks for matches in src, appends them to dst, and returns dst.
@@ -57,8 +60,14 @@ func (q *HashChain) FindMatches(dst []Match, src []byte) []Match {
 		delta := len(q.history) - minHistory
 		copy(q.history, q.history[delta:])
 		q.history = q.history[:minHistory]
-		copy(q.chain, q.chain[delta:])
-		q.chain = q.chain[:len(q.chain)-delta]
+		if delta < len(q.chain) {
+			copy(q.chain, q.chain[delta:])
+			q.chain = q.chain[:len(q.chain)-delta]
+		} else {
+			// After Reset with a large dictionary, the chains haven't been
+			// calculated yet.
+			q.chain = q.chain[:0]
+		}
 
 		for i, v := range q.table {
 			newV := int(v) - delta
@@ -136,6 +145,11 @@ func extendMatch(src []byte, i, j int) int {
 	return j
 }
 
+// History returns the buffer that Search's positions refer to.
+func (q *HashChain) History() []byte {
+	return q.history
+}
+
 func (q *HashChain) Search(dst []AbsoluteMatch, pos, min, max int) []AbsoluteMatch {
 	if pos >= len(q.chain) || pos+4 > len(q.history) {
 		return dst
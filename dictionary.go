@@ -0,0 +1,121 @@
+package pack
+
+import "encoding/binary"
+
+// A DictionaryMatchFinder is a MatchFinder that can be primed with a preset
+// dictionary: data that both the compressor and the decompressor know in
+// advance, so that matches can refer to it even at the start of the stream.
+// This can improve compression dramatically for small inputs, such as
+// individual records.
+type DictionaryMatchFinder interface {
+	MatchFinder
+
+	// SetDictionary loads dict into the match history, as if it had come
+	// just before the data to be compressed. The dictionary is loaded
+	// again each time Reset is called, until SetDictionary is called with
+	// a different dictionary (or nil, to stop using one).
+	SetDictionary(dict []byte)
+}
+
+// A DictionaryEncoder is an Encoder for a format that can use a preset
+// dictionary, and that needs to know about it: for example, to write its ID
+// in the stream header, or to start with the entropy tables from the
+// dictionary.
+type DictionaryEncoder interface {
+	Encoder
+
+	// SetDictionary prepares the Encoder to write streams that use dict.
+	// It returns the part of dict that the MatchFinder should be primed
+	// with: for formats where a dictionary contains more than just the
+	// data to refer back to, the content section of the dictionary.
+	// The setting lasts until SetDictionary is called again; Reset doesn't
+	// clear it.
+	SetDictionary(dict []byte) (content []byte, err error)
+}
+
+// SetDictionary sets a preset dictionary for w. If the Encoder implements
+// DictionaryEncoder, it is given the dictionary first, and it determines
+// what content the MatchFinder is primed with. The dictionary is used for
+// the current stream (so SetDictionary should be called before anything is
+// written) and after each call to Reset.
+//
+// If the MatchFinder doesn't implement DictionaryMatchFinder, the dictionary
+// is just signaled to the decoder, without being used for compression.
+// When Concurrency is greater than 1, the dictionary content is used as the
+// history for the first block, so only the last HistorySize bytes of it are
+// used.
+func (w *Writer) SetDictionary(dict []byte) error {
+	content := dict
+	if e, ok := w.Encoder.(DictionaryEncoder); ok {
+		var err error
+		content, err = e.SetDictionary(dict)
+		if err != nil {
+			return err
+		}
+	}
+	w.dictionary = content
+
+	if w.parallel() {
+		w.history = append(w.history[:0], w.dictionaryHistory()...)
+		return nil
+	}
+
+	if w.MatchFinder == nil && w.NewMatchFinder != nil {
+		w.MatchFinder = w.NewMatchFinder()
+	}
+	if mf, ok := w.MatchFinder.(DictionaryMatchFinder); ok {
+		mf.SetDictionary(content)
+	}
+	return nil
+}
+
+// dictionaryHistory returns the part of the dictionary that is used as the
+// history for the first block in parallel mode.
+func (w *Writer) dictionaryHistory() []byte {
+	h := w.dictionary
+	if len(h) > w.HistorySize {
+		h = h[len(h)-w.HistorySize:]
+	}
+	return h
+}
+
+// SetDictionary sets a dictionary to prime the hash chains with.
+func (q *HashChain) SetDictionary(dict []byte) {
+	q.dictionary = append(q.dictionary[:0], dict...)
+	q.Reset()
+}
+
+// SetDictionary sets a dictionary to prime the hash table with.
+func (q *SingleHash) SetDictionary(dict []byte) {
+	q.dictionary = append(q.dictionary[:0], dict...)
+	q.Reset()
+}
+
+// SetDictionary sets a dictionary to prime the hash tables with.
+func (q *DualHash) SetDictionary(dict []byte) {
+	q.dictionary = append(q.dictionary[:0], dict...)
+	q.Reset()
+}
+
+// loadDictionary appends dict to the history buffer, and adds its positions
+// to the hash tables. (Position 0 can't be stored, since it means that there
+// is no candidate.)
+func (q *SingleHash) loadDictionary() {
+	q.history = append(q.history, q.dictionary...)
+	for i := 1; i+4 <= len(q.history); i++ {
+		h := hash4(binary.LittleEndian.Uint32(q.history[i:]))
+		q.table[h&tableMask] = uint32(i)
+	}
+}
+
+func (q *DualHash) loadDictionary() {
+	q.history = append(q.history, q.dictionary...)
+	for i := 1; i+4 <= len(q.history); i++ {
+		h4 := hash4(binary.LittleEndian.Uint32(q.history[i:]))
+		q.table4[h4&tableMask] = uint32(i)
+		if i+8 <= len(q.history) {
+			h8 := hash8(binary.LittleEndian.Uint64(q.history[i:]))
+			q.table8[h8&table8Mask] = uint32(i)
+		}
+	}
+}
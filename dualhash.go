@@ -21,7 +21,8 @@ type DualHash struct {
 	table4 [maxTableSize]uint32
 	table8 [table8Size]uint32
 
-	history []byte
+	history    []byte
+	dictionary []byte
 
 	lastSearch int
 }
@@ -30,6 +31,9 @@ func (q *DualHash) Reset() {
 	q.table4 = [maxTableSize]uint32{}
 	q.table8 = [table8Size]uint32{}
 	q.history = q.history[:0]
+	if len(q.dictionary) > 0 {
+		q.loadDictionary()
+	}
 }
 
 // FindMatches looks for matches in src, appends them to dst, and returns dst.
@@ -70,6 +74,11 @@ func (q *DualHash) FindMatches(dst []Match, src []byte) []Match {
 	return q.Parser.Parse(dst, q, nextEmit, len(src))
 }
 
+// History returns the buffer that Search's positions refer to.
+func (q *DualHash) History() []byte {
+	return q.history
+}
+
 func (q *DualHash) Search(dst []AbsoluteMatch, pos, min, max int) []AbsoluteMatch {
 	if pos+4 > len(q.history) {
 		return dst
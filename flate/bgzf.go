@@ -0,0 +1,187 @@
+package flate
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/andybalholm/pack"
+)
+
+// BGZFBlockSize is the largest amount of data that goes in one BGZF block,
+// chosen (as in htslib) so that the compressed block always fits in 64 KB,
+// even if the data can't be compressed.
+const BGZFBlockSize = 0xff00
+
+// bgzfEOF is the empty block that marks the end of a BGZF file.
+var bgzfEOF = []byte{
+	0x1f, 0x8b, 8, 4, 0, 0, 0, 0, 0, 0xff, 6, 0, 'B', 'C', 2, 0,
+	0x1b, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// A BGZFBlock records where a BGZF block starts, in the compressed file and
+// in the uncompressed data.
+type BGZFBlock struct {
+	CompressedOffset   int64
+	UncompressedOffset int64
+}
+
+// A BGZFEncoder implements the pack.Encoder interface, writing in the BGZF
+// (blocked gzip) format used for BAM files and tabix-indexed files. Each
+// block is a separate gzip member of at most 64 KB, with a BC extra
+// subfield giving its size, so that it can be decompressed on its own.
+// Matches that refer to earlier blocks are written as literals. The end of
+// the file is marked with an empty block.
+//
+// The Encoder keeps a list of the blocks it has written, which can be used
+// to build an index.
+type BGZFEncoder struct {
+	g GZIPEncoder
+
+	blocks       []BGZFBlock
+	compressed   int64
+	uncompressed int64
+
+	matchBuf []pack.Match
+}
+
+// NewBGZFEncoder returns a new BGZFEncoder.
+func NewBGZFEncoder() pack.Encoder {
+	return new(BGZFEncoder)
+}
+
+func (e *BGZFEncoder) Reset() {
+	e.g.Reset()
+	e.blocks = e.blocks[:0]
+	e.compressed = 0
+	e.uncompressed = 0
+}
+
+func (e *BGZFEncoder) setMaxBlocks(n int) {
+	e.g.setMaxBlocks(n)
+}
+
+// Limits returns the limits on match length and distance in the DEFLATE
+// format, and the BGZF block size.
+func (e *BGZFEncoder) Limits() pack.Limits {
+	l := e.g.Limits()
+	l.BlockSize = BGZFBlockSize
+	l.IndependentBlocks = true
+	return l
+}
+
+func (e *BGZFEncoder) LiteralCost(b byte) float32 {
+	return e.g.LiteralCost(b)
+}
+
+func (e *BGZFEncoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	return e.g.MatchCost(m, recent)
+}
+
+func (e *BGZFEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
+	// Blocks that are too big are split, and the matches that cross the
+	// split are turned into literals.
+	for len(src) > BGZFBlockSize {
+		var rest []pack.Match
+		e.matchBuf, rest = splitMatches(e.matchBuf[:0], matches, BGZFBlockSize)
+		dst = e.encodeBlock(dst, src[:BGZFBlockSize], e.matchBuf)
+		src = src[BGZFBlockSize:]
+		matches = rest
+	}
+	if len(src) > 0 {
+		dst = e.encodeBlock(dst, src, matches)
+	}
+
+	if lastBlock {
+		dst = append(dst, bgzfEOF...)
+	}
+	return dst
+}
+
+func (e *BGZFEncoder) encodeBlock(dst []byte, src []byte, matches []pack.Match) []byte {
+	if e.g.Header.Extra == nil {
+		e.g.Header = GZIPHeader{
+			Extra: []byte{'B', 'C', 2, 0, 0, 0}, // BSIZE is filled in below.
+			OS:    255,
+		}
+	}
+	start := len(dst)
+	dst = e.g.Encode(dst, src, matches, true)
+	binary.LittleEndian.PutUint16(dst[start+16:], uint16(len(dst)-start-1))
+
+	e.blocks = append(e.blocks, BGZFBlock{
+		CompressedOffset:   e.compressed,
+		UncompressedOffset: e.uncompressed,
+	})
+	e.compressed += int64(len(dst) - start)
+	e.uncompressed += int64(len(src))
+	return dst
+}
+
+// splitMatches appends the matches that cover the first n bytes to dst.
+// If a match crosses the boundary, the part before it is appended as
+// literals, and the rest of it is returned at the start of rest.
+func splitMatches(dst, matches []pack.Match, n int) (first, rest []pack.Match) {
+	pos := 0
+	for i, m := range matches {
+		end := pos + m.Unmatched + m.Length
+		if end <= n {
+			dst = append(dst, m)
+			pos = end
+			continue
+		}
+		dst = append(dst, pack.Match{Unmatched: n - pos})
+		var r pack.Match
+		if pos+m.Unmatched > n {
+			r = pack.Match{Unmatched: pos + m.Unmatched - n, Length: m.Length, Distance: m.Distance}
+		} else {
+			r = pack.Match{Unmatched: end - n}
+		}
+		rest = append([]pack.Match{r}, matches[i+1:]...)
+		return dst, rest
+	}
+	return dst, nil
+}
+
+// Flush does nothing, since each block is a complete gzip member as soon as
+// it is encoded.
+func (e *BGZFEncoder) Flush(dst []byte) []byte {
+	return dst
+}
+
+// Blocks returns the blocks that have been written since the last Reset.
+func (e *BGZFEncoder) Blocks() []BGZFBlock {
+	return e.blocks
+}
+
+// VirtualOffset returns the BGZF virtual file offset of the byte at offset in
+// the uncompressed data: the compressed offset of the block that contains it
+// shifted left 16 bits, plus its offset within the block. The block must
+// already have been written. An offset at the end of the data written so far
+// refers to the start of the next block.
+func (e *BGZFEncoder) VirtualOffset(offset int64) uint64 {
+	i := sort.Search(len(e.blocks), func(i int) bool {
+		return e.blocks[i].UncompressedOffset > offset
+	}) - 1
+	if i < 0 || offset >= e.uncompressed {
+		return uint64(e.compressed) << 16
+	}
+	b := e.blocks[i]
+	return uint64(b.CompressedOffset)<<16 | uint64(offset-b.UncompressedOffset)
+}
+
+// AppendGZI appends a .gzi index of the blocks written so far to dst, in the
+// format used by bgzip: the number of entries, followed by the compressed
+// and uncompressed offset of each block after the first, all as 64-bit
+// little-endian integers.
+func (e *BGZFEncoder) AppendGZI(dst []byte) []byte {
+	blocks := e.blocks
+	if len(blocks) > 0 {
+		blocks = blocks[1:]
+	}
+	dst = binary.LittleEndian.AppendUint64(dst, uint64(len(blocks)))
+	for _, b := range blocks {
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(b.CompressedOffset))
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(b.UncompressedOffset))
+	}
+	return dst
+}
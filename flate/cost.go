@@ -0,0 +1,61 @@
+package flate
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// LiteralCost returns the length of the Huffman code for b in the most
+// recent block (or in the fixed Huffman code, before the first block).
+func (w *huffmanBitWriter) LiteralCost(b byte) float32 {
+	return w.literalCost[b]
+}
+
+// MatchCost returns the number of bits needed to encode a match of m's
+// length and distance, based on the Huffman codes from the most recent block.
+// DEFLATE has no repeat-distance codes, so recent is ignored.
+func (w *huffmanBitWriter) MatchCost(m pack.Match, recent [4]int) float32 {
+	if m.Length < baseMatchLength || m.Length > maxMatchLength || m.Distance < 1 || m.Distance > 32768 {
+		return float32(math.Inf(1))
+	}
+	lc := lengthCode(m.Length)
+	oc := offsetCode(m.Distance)
+	return w.literalCost[lengthCodesStart+lc] + float32(lengthExtraBits[lc]) +
+		w.offsetCost[oc] + float32(offsetExtraBits[oc])
+}
+
+// setCosts updates the tables used by LiteralCost and MatchCost from the
+// code lengths in litEnc and offEnc.
+func (w *huffmanBitWriter) setCosts(litEnc, offEnc *huffmanEncoder) {
+	setCodeCosts(w.literalCost[:], litEnc.codes, fixedLiteralEncoding.codes)
+	setCodeCosts(w.offsetCost[:], offEnc.codes, fixedOffsetEncoding.codes)
+}
+
+// setCodeCosts fills costs with the lengths of codes. Symbols that don't
+// have a code are given a cost one bit more than the longest code. If fewer
+// than two symbols have codes, there isn't enough information to go on,
+// so the lengths from fallback are used instead.
+func setCodeCosts(costs []float32, codes, fallback []hcode) {
+	var longest uint16
+	used := 0
+	for _, c := range codes[:len(costs)] {
+		if c.len > 0 {
+			used++
+			if c.len > longest {
+				longest = c.len
+			}
+		}
+	}
+	if used < 2 {
+		codes = fallback
+		longest = 0
+	}
+	for i := range costs {
+		n := codes[i].len
+		if n == 0 {
+			n = longest + 1
+		}
+		costs[i] = float32(n)
+	}
+}
@@ -0,0 +1,942 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import (
+	"bufio"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math/bits"
+	"strconv"
+	"sync"
+
+	"github.com/andybalholm/pack"
+)
+
+// The Huffman decoding in this file is based on compress/flate's inflate.go,
+// modified to return the LZ77 matches along with the decompressed data.
+
+const (
+	maxCodeLen = 16 // max length of Huffman code
+	maxNumDist = 30
+)
+
+// A CorruptInputError reports the presence of corrupt input at a given offset.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "flate: corrupt input before offset " + strconv.FormatInt(int64(e), 10)
+}
+
+var (
+	// ErrChecksum is returned when a gzip or zlib stream's checksum
+	// doesn't match the decompressed data.
+	ErrChecksum = errors.New("flate: invalid checksum")
+
+	// ErrHeader is returned when a gzip or zlib header is invalid.
+	ErrHeader = errors.New("flate: invalid header")
+
+	// ErrDictionary is returned when a zlib stream needs a preset
+	// dictionary, and the Decoder doesn't have the right one.
+	ErrDictionary = errors.New("flate: invalid dictionary")
+)
+
+// Initialize the fixedHuffmanDecoder only once upon first use.
+var fixedOnce sync.Once
+var fixedHuffmanDecoder huffmanDecoder
+
+// The data structure for decoding Huffman tables is based on that of
+// zlib. There is a lookup table of a fixed bit width (huffmanChunkBits),
+// For codes smaller than the table width, there are multiple entries
+// (each combination of trailing bits has the same value). For codes
+// larger than the table width, the table contains a link to an overflow
+// table. The width of each entry in the link table is the maximum code
+// size minus the chunk width.
+//
+// Note that you can do a lookup in the table even without all bits
+// filled. Since the extra bits are zero, and the DEFLATE Huffman codes
+// have the property that shorter codes come before longer ones, the
+// bit length estimate in the result is a lower bound on the actual
+// number of bits.
+//
+// See the following:
+//	https://github.com/madler/zlib/raw/master/doc/algorithm.txt
+
+// chunk & 15 is number of bits
+// chunk >> 4 is value, including table link
+
+const (
+	huffmanChunkBits  = 9
+	huffmanNumChunks  = 1 << huffmanChunkBits
+	huffmanCountMask  = 15
+	huffmanValueShift = 4
+)
+
+type huffmanDecoder struct {
+	min      int                      // the minimum code length
+	chunks   [huffmanNumChunks]uint32 // chunks as described above
+	links    [][]uint32               // overflow links
+	linkMask uint32                   // mask the width of the link table
+}
+
+// Initialize Huffman decoding tables from array of code lengths.
+// Following this function, h is guaranteed to be initialized into a complete
+// tree (i.e., neither over-subscribed nor under-subscribed). The exception is a
+// degenerate case where the tree has only a single symbol with length 1. Empty
+// trees are permitted.
+func (h *huffmanDecoder) init(lengths []int) bool {
+	if h.min != 0 {
+		*h = huffmanDecoder{}
+	}
+
+	// Count number of codes of each length,
+	// compute min and max length.
+	var count [maxCodeLen]int
+	var min, max int
+	for _, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		if min == 0 || n < min {
+			min = n
+		}
+		if n > max {
+			max = n
+		}
+		count[n]++
+	}
+
+	// Empty tree. The huffSym function will fail later if the tree is used.
+	if max == 0 {
+		return true
+	}
+
+	code := 0
+	var nextcode [maxCodeLen]int
+	for i := min; i <= max; i++ {
+		code <<= 1
+		nextcode[i] = code
+		code += count[i]
+	}
+
+	// Check that the coding is complete (i.e., that we've
+	// assigned all 2-to-the-max possible bit sequences).
+	// Exception: To be compatible with zlib, we also need to
+	// accept degenerate single-code codings.
+	if code != 1<<uint(max) && !(code == 1 && max == 1) {
+		return false
+	}
+
+	h.min = min
+	if max > huffmanChunkBits {
+		numLinks := 1 << (uint(max) - huffmanChunkBits)
+		h.linkMask = uint32(numLinks - 1)
+
+		// create link tables
+		link := nextcode[huffmanChunkBits+1] >> 1
+		h.links = make([][]uint32, huffmanNumChunks-link)
+		for j := uint(link); j < huffmanNumChunks; j++ {
+			reverse := int(bits.Reverse16(uint16(j)))
+			reverse >>= uint(16 - huffmanChunkBits)
+			off := j - uint(link)
+			h.chunks[reverse] = uint32(off<<huffmanValueShift | (huffmanChunkBits + 1))
+			h.links[off] = make([]uint32, numLinks)
+		}
+	}
+
+	for i, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		code := nextcode[n]
+		nextcode[n]++
+		chunk := uint32(i<<huffmanValueShift | n)
+		reverse := int(bits.Reverse16(uint16(code)))
+		reverse >>= uint(16 - n)
+		if n <= huffmanChunkBits {
+			for off := reverse; off < len(h.chunks); off += 1 << uint(n) {
+				h.chunks[off] = chunk
+			}
+		} else {
+			j := reverse & (huffmanNumChunks - 1)
+			value := h.chunks[j] >> huffmanValueShift
+			linktab := h.links[value]
+			reverse >>= huffmanChunkBits
+			for off := reverse; off < len(linktab); off += 1 << uint(n-huffmanChunkBits) {
+				linktab[off] = chunk
+			}
+		}
+	}
+
+	return true
+}
+
+func fixedHuffmanDecoderInit() {
+	fixedOnce.Do(func() {
+		// These come from the RFC section 3.2.6.
+		var bits [288]int
+		for i := 0; i < 144; i++ {
+			bits[i] = 8
+		}
+		for i := 144; i < 256; i++ {
+			bits[i] = 9
+		}
+		for i := 256; i < 280; i++ {
+			bits[i] = 7
+		}
+		for i := 280; i < 288; i++ {
+			bits[i] = 8
+		}
+		fixedHuffmanDecoder.init(bits[:])
+	})
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+const (
+	wrapNone = iota
+	wrapGZIP
+	wrapZlib
+)
+
+// A Decoder reads a DEFLATE stream one block at a time. Along with the
+// decompressed data, it returns the matches that the data was encoded with,
+// in the same form that a pack.MatchFinder produces, so that the data can be
+// analyzed or re-encoded with a different Encoder.
+type Decoder struct {
+	r       byteReader
+	rBuf    *bufio.Reader
+	roffset int64
+
+	// Input bits, in top of b.
+	b  uint32
+	nb uint
+
+	// Huffman decoders for literal/length, distance.
+	h1, h2 huffmanDecoder
+
+	// Length arrays used to define Huffman codes.
+	bits     [maxNumLit + maxNumDist]int
+	codebits [codegenCodeCount]int
+
+	// history holds the decompressed data from previous blocks that may be
+	// referenced by matches, followed by the current block.
+	history []byte
+
+	dictionary []byte
+	dictID     uint32 // Adler-32 checksum of the dictionary, for zlib
+
+	wrapper     int
+	startMember bool
+	final       bool
+	err         error
+
+	// checksum state for gzip and zlib
+	crc   uint32
+	size  uint32
+	adler uint32
+}
+
+// NewDecoder returns a Decoder that reads raw DEFLATE data from r.
+// If r does not also implement io.ByteReader, the Decoder may read more data
+// than necessary from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.Reset(r)
+	return d
+}
+
+// NewGZIPDecoder returns a Decoder that reads gzip data from r.
+// If the stream contains multiple gzip members, they are decoded as a single
+// stream.
+func NewGZIPDecoder(r io.Reader) *Decoder {
+	d := NewDecoder(r)
+	d.wrapper = wrapGZIP
+	d.startMember = true
+	return d
+}
+
+// NewZlibDecoder returns a Decoder that reads zlib data from r.
+func NewZlibDecoder(r io.Reader) *Decoder {
+	d := NewDecoder(r)
+	d.wrapper = wrapZlib
+	d.startMember = true
+	return d
+}
+
+// Reset discards the Decoder's state and prepares it to read a new stream
+// from r, in the same format as before.
+func (d *Decoder) Reset(r io.Reader) {
+	fixedHuffmanDecoderInit()
+
+	if rr, ok := r.(byteReader); ok {
+		d.r = rr
+	} else {
+		if d.rBuf == nil {
+			d.rBuf = bufio.NewReader(r)
+		} else {
+			d.rBuf.Reset(r)
+		}
+		d.r = d.rBuf
+	}
+
+	d.roffset = 0
+	d.b, d.nb = 0, 0
+	d.history = d.history[:0]
+	if d.wrapper == wrapNone {
+		d.history = append(d.history, d.dictionary...)
+	}
+	d.startMember = d.wrapper != wrapNone
+	d.final = false
+	d.err = nil
+}
+
+// SetDictionary sets the preset dictionary that the compressed data was
+// encoded with. For raw DEFLATE data, it is used at the start of each stream;
+// for zlib data, it is used if the stream header says there is a dictionary,
+// and its checksum must match the one in the header. It should be called
+// before the first call to NextBlock.
+func (d *Decoder) SetDictionary(dict []byte) {
+	d.dictID = updateAdler32(1, dict)
+	if len(dict) > windowSize {
+		dict = dict[len(dict)-windowSize:]
+	}
+	d.dictionary = append(d.dictionary[:0], dict...)
+	if d.wrapper == wrapNone && d.roffset == 0 {
+		d.history = append(d.history[:0], d.dictionary...)
+	}
+}
+
+// NextBlock decodes the next DEFLATE block. It appends the decompressed data
+// to dst and the block's matches to matches, and returns the updated slices.
+// The matches cover exactly the data appended to dst, but they may refer back
+// to data from previous blocks. After the last block, NextBlock returns io.EOF.
+func (d *Decoder) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if d.err != nil {
+		return dst, matches, d.err
+	}
+
+	if d.final {
+		d.err = d.finishMember()
+		if d.err != nil {
+			return dst, matches, d.err
+		}
+	}
+
+	if d.startMember {
+		if d.err = d.readHeader(); d.err != nil {
+			return dst, matches, d.err
+		}
+		d.startMember = false
+	}
+
+	if len(d.history) > 2*windowSize {
+		n := copy(d.history, d.history[len(d.history)-windowSize:])
+		d.history = d.history[:n]
+	}
+	start := len(d.history)
+
+	matches, d.err = d.nextBlock(matches)
+	if d.err != nil {
+		d.err = noEOF(d.err)
+		return dst, matches, d.err
+	}
+
+	block := d.history[start:]
+	switch d.wrapper {
+	case wrapGZIP:
+		d.crc = crc32.Update(d.crc, crc32.IEEETable, block)
+		d.size += uint32(len(block))
+	case wrapZlib:
+		d.adler = updateAdler32(d.adler, block)
+	}
+
+	return append(dst, block...), matches, nil
+}
+
+func (d *Decoder) nextBlock(matches []pack.Match) ([]pack.Match, error) {
+	for d.nb < 1+2 {
+		if err := d.moreBits(); err != nil {
+			return matches, err
+		}
+	}
+	d.final = d.b&1 == 1
+	d.b >>= 1
+	typ := d.b & 3
+	d.b >>= 2
+	d.nb -= 1 + 2
+	switch typ {
+	case 0:
+		return d.dataBlock(matches)
+	case 1:
+		// compressed, fixed Huffman tables
+		return d.huffmanBlock(matches, &fixedHuffmanDecoder, nil)
+	case 2:
+		// compressed, dynamic Huffman tables
+		if err := d.readHuffman(); err != nil {
+			return matches, err
+		}
+		return d.huffmanBlock(matches, &d.h1, &d.h2)
+	default:
+		// 3 is reserved.
+		return matches, CorruptInputError(d.roffset)
+	}
+}
+
+// finishMember is called after the last block of a DEFLATE stream. It checks
+// the gzip or zlib trailer, and looks for another gzip member.
+func (d *Decoder) finishMember() error {
+	switch d.wrapper {
+	case wrapNone:
+		return io.EOF
+
+	case wrapZlib:
+		var buf [4]byte
+		if err := d.readFull(buf[:]); err != nil {
+			return err
+		}
+		if uint32(buf[0])<<24|uint32(buf[1])<<16|uint32(buf[2])<<8|uint32(buf[3]) != d.adler {
+			return ErrChecksum
+		}
+		return io.EOF
+
+	case wrapGZIP:
+		var buf [8]byte
+		if err := d.readFull(buf[:]); err != nil {
+			return err
+		}
+		if le32(buf[:4]) != d.crc || le32(buf[4:]) != d.size {
+			return ErrChecksum
+		}
+
+		// Check for another member.
+		c, err := d.r.ReadByte()
+		if err == io.EOF {
+			return io.EOF
+		}
+		if err != nil {
+			return err
+		}
+		d.roffset++
+		if c != 0x1f {
+			return ErrHeader
+		}
+		d.final = false
+		d.history = d.history[:0]
+		return d.readGZIPHeader(c)
+	}
+
+	return nil
+}
+
+func (d *Decoder) readHeader() error {
+	switch d.wrapper {
+	case wrapGZIP:
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		d.roffset++
+		return d.readGZIPHeader(c)
+
+	case wrapZlib:
+		var buf [2]byte
+		if err := d.readFull(buf[:]); err != nil {
+			return err
+		}
+		h := uint(buf[0])<<8 | uint(buf[1])
+		if buf[0]&0x0f != 8 || buf[0]>>4 > 7 || h%31 != 0 {
+			return ErrHeader
+		}
+		d.history = d.history[:0]
+		if buf[1]&0x20 != 0 {
+			// FDICT: the header is followed by the dictionary's checksum.
+			var id [4]byte
+			if err := d.readFull(id[:]); err != nil {
+				return err
+			}
+			if d.dictionary == nil || uint32(id[0])<<24|uint32(id[1])<<16|uint32(id[2])<<8|uint32(id[3]) != d.dictID {
+				return ErrDictionary
+			}
+			d.history = append(d.history, d.dictionary...)
+		}
+		d.adler = 1
+	}
+	return nil
+}
+
+// readGZIPHeader reads a gzip header, whose first byte has already been read.
+func (d *Decoder) readGZIPHeader(id1 byte) error {
+	const (
+		flagHdrCrc  = 1 << 1
+		flagExtra   = 1 << 2
+		flagName    = 1 << 3
+		flagComment = 1 << 4
+	)
+
+	var buf [10]byte
+	buf[0] = id1
+	if err := d.readFull(buf[1:]); err != nil {
+		return err
+	}
+	if buf[0] != 0x1f || buf[1] != 0x8b || buf[2] != 8 {
+		return ErrHeader
+	}
+	flg := buf[3]
+	hcrc := crc32.Update(0, crc32.IEEETable, buf[:])
+
+	if flg&flagExtra != 0 {
+		if err := d.readFull(buf[:2]); err != nil {
+			return err
+		}
+		hcrc = crc32.Update(hcrc, crc32.IEEETable, buf[:2])
+		extra := make([]byte, int(buf[0])|int(buf[1])<<8)
+		if err := d.readFull(extra); err != nil {
+			return err
+		}
+		hcrc = crc32.Update(hcrc, crc32.IEEETable, extra)
+	}
+	for _, f := range []byte{flagName, flagComment} {
+		if flg&f == 0 {
+			continue
+		}
+		// Skip a zero-terminated string.
+		for {
+			c, err := d.r.ReadByte()
+			if err != nil {
+				return noEOF(err)
+			}
+			d.roffset++
+			hcrc = crc32.Update(hcrc, crc32.IEEETable, []byte{c})
+			if c == 0 {
+				break
+			}
+		}
+	}
+	if flg&flagHdrCrc != 0 {
+		if err := d.readFull(buf[:2]); err != nil {
+			return err
+		}
+		if uint16(buf[0])|uint16(buf[1])<<8 != uint16(hcrc) {
+			return ErrHeader
+		}
+	}
+
+	d.crc = 0
+	d.size = 0
+	return nil
+}
+
+func le32(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+func updateAdler32(adler uint32, p []byte) uint32 {
+	const mod = 65521
+	s1, s2 := adler&0xffff, adler>>16
+	for len(p) > 0 {
+		// 5552 is the largest n such that the sums can't overflow.
+		n := len(p)
+		if n > 5552 {
+			n = 5552
+		}
+		for _, c := range p[:n] {
+			s1 += uint32(c)
+			s2 += s1
+		}
+		s1 %= mod
+		s2 %= mod
+		p = p[n:]
+	}
+	return s2<<16 | s1
+}
+
+// readFull reads len(buf) bytes, after discarding any bits left over from
+// the DEFLATE stream.
+func (d *Decoder) readFull(buf []byte) error {
+	d.b, d.nb = 0, 0
+	n, err := io.ReadFull(d.r, buf)
+	d.roffset += int64(n)
+	return noEOF(err)
+}
+
+// RFC 1951 section 3.2.7.
+// Compression with dynamic Huffman codes
+
+var codeOrder = [...]int{16, 17, 18, 0, 8, 7, 9, 6, 10, 5, 11, 4, 12, 3, 13, 2, 14, 1, 15}
+
+func (d *Decoder) readHuffman() error {
+	// HLIT[5], HDIST[5], HCLEN[4].
+	for d.nb < 5+5+4 {
+		if err := d.moreBits(); err != nil {
+			return err
+		}
+	}
+	nlit := int(d.b&0x1F) + 257
+	if nlit > maxNumLit {
+		return CorruptInputError(d.roffset)
+	}
+	d.b >>= 5
+	ndist := int(d.b&0x1F) + 1
+	if ndist > maxNumDist {
+		return CorruptInputError(d.roffset)
+	}
+	d.b >>= 5
+	nclen := int(d.b&0xF) + 4
+	// codegenCodeCount is 19, so nclen is always valid.
+	d.b >>= 4
+	d.nb -= 5 + 5 + 4
+
+	// (HCLEN+4)*3 bits: code lengths in the magic codeOrder order.
+	for i := 0; i < nclen; i++ {
+		for d.nb < 3 {
+			if err := d.moreBits(); err != nil {
+				return err
+			}
+		}
+		d.codebits[codeOrder[i]] = int(d.b & 0x7)
+		d.b >>= 3
+		d.nb -= 3
+	}
+	for i := nclen; i < len(codeOrder); i++ {
+		d.codebits[codeOrder[i]] = 0
+	}
+	if !d.h1.init(d.codebits[0:]) {
+		return CorruptInputError(d.roffset)
+	}
+
+	// HLIT + 257 code lengths, HDIST + 1 code lengths,
+	// using the code length Huffman code.
+	for i, n := 0, nlit+ndist; i < n; {
+		x, err := d.huffSym(&d.h1)
+		if err != nil {
+			return err
+		}
+		if x < 16 {
+			// Actual length.
+			d.bits[i] = x
+			i++
+			continue
+		}
+		// Repeat previous length or zero.
+		var rep int
+		var nb uint
+		var b int
+		switch x {
+		default:
+			return CorruptInputError(d.roffset)
+		case 16:
+			rep = 3
+			nb = 2
+			if i == 0 {
+				return CorruptInputError(d.roffset)
+			}
+			b = d.bits[i-1]
+		case 17:
+			rep = 3
+			nb = 3
+			b = 0
+		case 18:
+			rep = 11
+			nb = 7
+			b = 0
+		}
+		for d.nb < nb {
+			if err := d.moreBits(); err != nil {
+				return err
+			}
+		}
+		rep += int(d.b & uint32(1<<nb-1))
+		d.b >>= nb
+		d.nb -= nb
+		if i+rep > n {
+			return CorruptInputError(d.roffset)
+		}
+		for j := 0; j < rep; j++ {
+			d.bits[i] = b
+			i++
+		}
+	}
+
+	if !d.h1.init(d.bits[0:nlit]) || !d.h2.init(d.bits[nlit:nlit+ndist]) {
+		return CorruptInputError(d.roffset)
+	}
+
+	// As an optimization, we can initialize the min bits to read at a time
+	// for the HLIT tree to the length of the EOB marker since we know that
+	// every block must terminate with one. This preserves the property that
+	// we never read any extra bytes after the end of the DEFLATE stream.
+	if d.h1.min < d.bits[endBlockMarker] {
+		d.h1.min = d.bits[endBlockMarker]
+	}
+
+	return nil
+}
+
+// huffmanBlock decodes a single Huffman block, appending the data to
+// d.history and the matches to matches.
+// hl and hd are the Huffman states for the lit/length values
+// and the distance values, respectively. If hd == nil, using the
+// fixed distance encoding associated with fixed Huffman blocks.
+func (d *Decoder) huffmanBlock(matches []pack.Match, hl, hd *huffmanDecoder) ([]pack.Match, error) {
+	unmatched := 0
+	for {
+		v, err := d.huffSym(hl)
+		if err != nil {
+			return matches, err
+		}
+		var n uint // number of bits extra
+		var length int
+		switch {
+		case v < 256:
+			d.history = append(d.history, byte(v))
+			unmatched++
+			continue
+		case v == 256:
+			if unmatched > 0 {
+				matches = append(matches, pack.Match{Unmatched: unmatched})
+			}
+			return matches, nil
+		// otherwise, reference to older data
+		case v < 265:
+			length = v - (257 - 3)
+			n = 0
+		case v < 269:
+			length = v*2 - (265*2 - 11)
+			n = 1
+		case v < 273:
+			length = v*4 - (269*4 - 19)
+			n = 2
+		case v < 277:
+			length = v*8 - (273*8 - 35)
+			n = 3
+		case v < 281:
+			length = v*16 - (277*16 - 67)
+			n = 4
+		case v < 285:
+			length = v*32 - (281*32 - 131)
+			n = 5
+		case v < maxNumLit:
+			length = 258
+			n = 0
+		default:
+			return matches, CorruptInputError(d.roffset)
+		}
+		if n > 0 {
+			for d.nb < n {
+				if err = d.moreBits(); err != nil {
+					return matches, err
+				}
+			}
+			length += int(d.b & uint32(1<<n-1))
+			d.b >>= n
+			d.nb -= n
+		}
+
+		var dist int
+		if hd == nil {
+			for d.nb < 5 {
+				if err = d.moreBits(); err != nil {
+					return matches, err
+				}
+			}
+			dist = int(bits.Reverse8(uint8(d.b & 0x1F << 3)))
+			d.b >>= 5
+			d.nb -= 5
+		} else {
+			if dist, err = d.huffSym(hd); err != nil {
+				return matches, err
+			}
+		}
+
+		switch {
+		case dist < 4:
+			dist++
+		case dist < maxNumDist:
+			nb := uint(dist-2) >> 1
+			// have 1 bit in bottom of dist, need nb more.
+			extra := (dist & 1) << nb
+			for d.nb < nb {
+				if err = d.moreBits(); err != nil {
+					return matches, err
+				}
+			}
+			extra |= int(d.b & uint32(1<<nb-1))
+			d.b >>= nb
+			d.nb -= nb
+			dist = 1<<(nb+1) + 1 + extra
+		default:
+			return matches, CorruptInputError(d.roffset)
+		}
+
+		if dist > len(d.history) {
+			return matches, CorruptInputError(d.roffset)
+		}
+
+		matches = append(matches, pack.Match{
+			Unmatched: unmatched,
+			Length:    length,
+			Distance:  dist,
+		})
+		unmatched = 0
+
+		start := len(d.history) - dist
+		if length <= dist {
+			d.history = append(d.history, d.history[start:start+length]...)
+		} else {
+			// The match overlaps the data it is producing.
+			for i := 0; i < length; i++ {
+				d.history = append(d.history, d.history[start+i])
+			}
+		}
+	}
+}
+
+// dataBlock copies a single uncompressed data block from the input to
+// d.history.
+func (d *Decoder) dataBlock(matches []pack.Match) ([]pack.Match, error) {
+	var buf [4]byte
+	if err := d.readFull(buf[:]); err != nil {
+		return matches, err
+	}
+	n := int(buf[0]) | int(buf[1])<<8
+	nn := int(buf[2]) | int(buf[3])<<8
+	if uint16(nn) != uint16(^n) {
+		return matches, CorruptInputError(d.roffset)
+	}
+	if n == 0 {
+		return matches, nil
+	}
+
+	start := len(d.history)
+	for cap(d.history) < start+n {
+		d.history = append(d.history[:cap(d.history)], 0)
+	}
+	d.history = d.history[:start+n]
+	if err := d.readFull(d.history[start:]); err != nil {
+		d.history = d.history[:start]
+		return matches, err
+	}
+
+	return append(matches, pack.Match{Unmatched: n}), nil
+}
+
+// noEOF returns err, unless err == io.EOF, in which case it returns io.ErrUnexpectedEOF.
+func noEOF(e error) error {
+	if e == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return e
+}
+
+func (d *Decoder) moreBits() error {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return noEOF(err)
+	}
+	d.roffset++
+	d.b |= uint32(c) << d.nb
+	d.nb += 8
+	return nil
+}
+
+// Read the next Huffman-encoded symbol from d according to h.
+func (d *Decoder) huffSym(h *huffmanDecoder) (int, error) {
+	// Since a huffmanDecoder can be empty or be composed of a degenerate tree
+	// with single element, huffSym must error on these two edge cases. In both
+	// cases, the chunks slice will be 0 for the invalid sequence, leading it
+	// satisfy the n == 0 check below.
+	n := uint(h.min)
+	// Optimization. Compiler isn't smart enough to keep d.b,d.nb in registers,
+	// but is smart enough to keep local variables in registers, so use nb and b,
+	// inline call to moreBits and reassign b,nb back to d on return.
+	nb, b := d.nb, d.b
+	for {
+		for nb < n {
+			c, err := d.r.ReadByte()
+			if err != nil {
+				d.b = b
+				d.nb = nb
+				return 0, noEOF(err)
+			}
+			d.roffset++
+			b |= uint32(c) << (nb & 31)
+			nb += 8
+		}
+		chunk := h.chunks[b&(huffmanNumChunks-1)]
+		n = uint(chunk & huffmanCountMask)
+		if n > huffmanChunkBits {
+			chunk = h.links[chunk>>huffmanValueShift][(b>>huffmanChunkBits)&h.linkMask]
+			n = uint(chunk & huffmanCountMask)
+		}
+		if n <= nb {
+			if n == 0 {
+				d.b = b
+				d.nb = nb
+				return 0, CorruptInputError(d.roffset)
+			}
+			d.b = b >> (n & 31)
+			d.nb = nb - n
+			return int(chunk >> huffmanValueShift), nil
+		}
+	}
+}
+
+// A Reader decompresses data in DEFLATE, gzip, or zlib format.
+type Reader struct {
+	d       *Decoder
+	buf     []byte
+	pos     int
+	matches []pack.Match
+	err     error
+}
+
+// NewReader returns a Reader that decompresses raw DEFLATE data from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: NewDecoder(r)}
+}
+
+// NewGZIPReader returns a Reader that decompresses gzip data from r.
+func NewGZIPReader(r io.Reader) *Reader {
+	return &Reader{d: NewGZIPDecoder(r)}
+}
+
+// NewZlibReader returns a Reader that decompresses zlib data from r.
+func NewZlibReader(r io.Reader) *Reader {
+	return &Reader{d: NewZlibDecoder(r)}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.matches, r.err = r.d.NextBlock(r.buf[:0], r.matches[:0])
+		r.pos = 0
+	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// Reset discards the Reader's state and prepares it to read a new stream
+// from src, in the same format as before.
+func (r *Reader) Reset(src io.Reader) {
+	r.d.Reset(src)
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.matches = r.matches[:0]
+	r.err = nil
+}
+
+// SetDictionary sets the preset dictionary that the compressed data was
+// encoded with. See Decoder.SetDictionary.
+func (r *Reader) SetDictionary(dict []byte) {
+	r.d.SetDictionary(dict)
+}
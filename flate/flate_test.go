@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/andybalholm/pack"
 	"github.com/andybalholm/pack/brotli"
@@ -54,6 +59,10 @@ func TestEncodeSSAP(t *testing.T) {
 	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.SimpleSearchAdvancedParsing{MaxDistance: 32768, HashLen: 5}, 1<<20)
 }
 
+func TestEncodeOptimal(t *testing.T) {
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.HashChain{MaxDistance: 32768, SearchLen: 100, Parser: &pack.OptimalParser{MinLength: 3, MaxLength: 258}}, 1<<16)
+}
+
 func TestEncodeHuffmanOnly(t *testing.T) {
 	test(t, "../testdata/Isaac.Newton-Opticks.txt", pack.NoMatchFinder{}, 1<<16)
 }
@@ -109,6 +118,230 @@ func TestWriterLevels(t *testing.T) {
 	}
 }
 
+func TestZopfli(t *testing.T) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Put some random data in the middle, so that there is a reason to
+	// split blocks.
+	random := make([]byte, 20000)
+	rand.New(rand.NewSource(1)).Read(random)
+	data := append(append(append([]byte(nil), opticks[:50000]...), random...), opticks[50000:100000]...)
+
+	compress := func(level int) []byte {
+		b := new(bytes.Buffer)
+		w := NewWriter(b, level)
+		w.Write(data)
+		w.Close()
+		return b.Bytes()
+	}
+
+	level9 := compress(9)
+	level10 := compress(10)
+	if len(level10) >= len(level9) {
+		t.Errorf("level 10 output (%d bytes) isn't smaller than level 9 (%d bytes)", len(level10), len(level9))
+	}
+
+	decompressed, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(level10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(level10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("output from Reader doesn't match")
+	}
+}
+
+func TestBlockSplitting(t *testing.T) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Alternate between text, binary data, and random data, like a tar file.
+	rnd := rand.New(rand.NewSource(1))
+	var data []byte
+	for i := 0; i < 6; i++ {
+		data = append(data, opticks[i*20000:(i+1)*20000]...)
+		for j := 0; j < 10000; j++ {
+			data = append(data, byte(j*j>>3), byte(j))
+		}
+		random := make([]byte, 3000)
+		rnd.Read(random)
+		data = append(data, random...)
+	}
+
+	compress := func(maxBlocks int) []byte {
+		e := NewEncoder()
+		e.(*huffmanBitWriter).setMaxBlocks(maxBlocks)
+		b := new(bytes.Buffer)
+		w := newWriter(b, 6, e)
+		w.Write(data)
+		w.Close()
+		return b.Bytes()
+	}
+
+	unsplit := compress(1)
+	split := compress(0)
+	if len(split) >= len(unsplit) {
+		t.Errorf("output with block splitting (%d bytes) isn't smaller than without (%d bytes)", len(split), len(unsplit))
+	}
+
+	decompressed, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(split)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(split)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("output from Reader doesn't match")
+	}
+}
+
+func TestParallel(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:           b,
+		Encoder:        NewGZIPEncoder(),
+		BlockSize:      1 << 16,
+		Concurrency:    4,
+		NewMatchFinder: func() pack.MatchFinder { return NewMatchFinder(6) },
+		HistorySize:    32768,
+	}
+	for pos := 0; pos < len(data); pos += 10000 {
+		end := pos + 10000
+		if end > len(data) {
+			end = len(data)
+		}
+		w.Write(data[pos:end])
+		if pos == 300000 {
+			w.Flush()
+		}
+	}
+	w.Close()
+	sr, err := gzip.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(sr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for level := flate.HuffmanOnly; level <= flate.BestCompression; level++ {
+		b := new(bytes.Buffer)
+		w, _ := flate.NewWriter(b, level)
+		w.Write(data[:100000])
+		w.Flush()
+		w.Write(data[100000:])
+		w.Close()
+
+		d := NewDecoder(bytes.NewReader(b.Bytes()))
+		var decompressed []byte
+		var matches []pack.Match
+		for {
+			decompressed, matches, err = d.NextBlock(decompressed, matches)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("error decompressing level %d: %v", level, err)
+			}
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed output doesn't match on level %d", level)
+		}
+
+		// Re-encode the data, using the matches from the decoder.
+		reencoded := NewEncoder().Encode(nil, decompressed, matches, true)
+		decompressed, err = ioutil.ReadAll(flate.NewReader(bytes.NewReader(reencoded)))
+		if err != nil {
+			t.Fatalf("error decompressing re-encoded level %d: %v", level, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("re-encoded output doesn't match on level %d", level)
+		}
+	}
+}
+
+func TestGZIPReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(bytes.Buffer)
+	w := gzip.NewWriter(b)
+	w.Name = "Opticks.txt"
+	w.Comment = "Isaac Newton"
+	w.Extra = []byte("extra")
+	w.Write(data[:100000])
+	w.Close()
+	w.Reset(b)
+	w.Write(data[100000:])
+	w.Close()
+
+	decompressed, err := ioutil.ReadAll(NewGZIPReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	corrupted := append([]byte(nil), b.Bytes()...)
+	corrupted[len(corrupted)-5]++
+	_, err = ioutil.ReadAll(NewGZIPReader(bytes.NewReader(corrupted)))
+	if err != ErrChecksum {
+		t.Fatalf("got %v with bad checksum, want %v", err, ErrChecksum)
+	}
+}
+
+func TestZlibReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(bytes.Buffer)
+	w := zlib.NewWriter(b)
+	w.Write(data)
+	w.Close()
+
+	decompressed, err := ioutil.ReadAll(NewZlibReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
 func benchmark(b *testing.B, filename string, m pack.MatchFinder, blockSize int) {
 	b.StopTimer()
 	b.ReportAllocs()
@@ -160,6 +393,35 @@ func BenchmarkEncodeHuffmanOnly(b *testing.B) {
 	benchmark(b, "../testdata/Isaac.Newton-Opticks.txt", pack.NoMatchFinder{}, 1<<20)
 }
 
+func BenchmarkParallel(b *testing.B) {
+	b.StopTimer()
+	b.ReportAllocs()
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(opticks)))
+	buf := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:           buf,
+		Encoder:        NewEncoder(),
+		BlockSize:      1 << 16,
+		Concurrency:    4,
+		NewMatchFinder: func() pack.MatchFinder { return NewMatchFinder(6) },
+		HistorySize:    32768,
+	}
+	w.Write(opticks)
+	w.Close()
+	b.ReportMetric(float64(len(opticks))/float64(buf.Len()), "ratio")
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		w.Reset(ioutil.Discard)
+		w.Write(opticks)
+		w.Close()
+	}
+}
+
 func BenchmarkGZIP(b *testing.B) {
 	b.StopTimer()
 	b.ReportAllocs()
@@ -187,6 +449,26 @@ func BenchmarkGZIP(b *testing.B) {
 	}
 }
 
+func BenchmarkDecode(b *testing.B) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, 6)
+	w.Write(opticks)
+	w.Close()
+	compressed := buf.Bytes()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(opticks)))
+	r := NewReader(bytes.NewReader(compressed))
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
+
 func BenchmarkEncodeStdlib(b *testing.B) {
 	b.StopTimer()
 	b.ReportAllocs()
@@ -302,3 +584,429 @@ func BenchmarkKlausPostLevels(b *testing.B) {
 		})
 	}
 }
+
+func TestFlush(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := NewGZIPWriter(b, 6)
+	w.Write(data[:100000])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	sr, err := gzip.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	partial := make([]byte, 100000)
+	if _, err := io.ReadFull(sr, partial); err != nil {
+		t.Fatalf("error decompressing flushed data: %v", err)
+	}
+	if !bytes.Equal(partial, data[:100000]) {
+		t.Fatal("flushed output doesn't match")
+	}
+
+	w.Write(data[100000:])
+	w.Close()
+	sr, err = gzip.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(sr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dict := data[:1<<15]
+
+	w := &pack.Writer{
+		MatchFinder: NewMatchFinder(6),
+		Encoder:     NewEncoder(),
+		BlockSize:   1 << 16,
+	}
+	if err := w.SetDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	for i := 200000; i < 210000; i += 1000 {
+		record := data[i : i+1000]
+		b := new(bytes.Buffer)
+		w.Reset(b)
+		w.Write(record)
+		w.Close()
+		decompressed, err := ioutil.ReadAll(flate.NewReaderDict(bytes.NewReader(b.Bytes()), dict))
+		if err != nil {
+			t.Fatalf("record at %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed, record) {
+			t.Fatalf("record at %d: decompressed output doesn't match", i)
+		}
+	}
+
+	// In parallel mode, the dictionary is the history for the first block.
+	b := new(bytes.Buffer)
+	w = &pack.Writer{
+		Dest:           b,
+		Encoder:        NewEncoder(),
+		BlockSize:      1 << 16,
+		Concurrency:    4,
+		NewMatchFinder: func() pack.MatchFinder { return NewMatchFinder(6) },
+		HistorySize:    32768,
+	}
+	if err := w.SetDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data[200000:500000])
+	w.Close()
+	decompressed, err := ioutil.ReadAll(flate.NewReaderDict(bytes.NewReader(b.Bytes()), dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data[200000:500000]) {
+		t.Fatal("decompressed output from parallel Writer doesn't match")
+	}
+}
+
+func TestZlibDictionary(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dict := data[:1<<16]
+
+	b := new(bytes.Buffer)
+	w, err := zlib.NewWriterLevelDict(b, 6, dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data[200000:210000])
+	w.Close()
+
+	r := NewZlibReader(bytes.NewReader(b.Bytes()))
+	r.SetDictionary(dict)
+	decompressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data[200000:210000]) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	if _, err := ioutil.ReadAll(NewZlibReader(bytes.NewReader(b.Bytes()))); err != ErrDictionary {
+		t.Errorf("got %v without a dictionary, want %v", err, ErrDictionary)
+	}
+	r = NewZlibReader(bytes.NewReader(b.Bytes()))
+	r.SetDictionary(data[1 : 1<<16])
+	if _, err := ioutil.ReadAll(r); err != ErrDictionary {
+		t.Errorf("got %v with the wrong dictionary, want %v", err, ErrDictionary)
+	}
+}
+
+func TestZlibWriter(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, level := range []int{1, 4, 6, 9} {
+		b := new(bytes.Buffer)
+		w := NewZlibWriter(b, level)
+		w.Write(data)
+		w.Close()
+		compressed := b.Bytes()
+
+		// The header should have the same level hint as compress/zlib's.
+		ref := new(bytes.Buffer)
+		zw, _ := zlib.NewWriterLevel(ref, level)
+		zw.Close()
+		if !bytes.Equal(compressed[:2], ref.Bytes()[:2]) {
+			t.Errorf("level %d: header is %x; compress/zlib writes %x", level, compressed[:2], ref.Bytes()[:2])
+		}
+
+		zr, err := zlib.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := ioutil.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("level %d: %v", level, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("level %d: decompressed output doesn't match", level)
+		}
+	}
+
+	// With a preset dictionary
+	dict := data[:1<<16]
+	record := data[200000:210000]
+	b := new(bytes.Buffer)
+	w := NewZlibWriter(b, 6)
+	if err := w.SetDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(record)
+	w.Close()
+	withDict := append([]byte(nil), b.Bytes()...)
+
+	zr, err := zlib.NewReaderDict(bytes.NewReader(withDict), dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, record) {
+		t.Fatal("decompressed output with dictionary doesn't match")
+	}
+	r := NewZlibReader(bytes.NewReader(withDict))
+	r.SetDictionary(dict)
+	decompressed, err = ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, record) {
+		t.Fatal("output from Reader with dictionary doesn't match")
+	}
+
+	// After Reset, the dictionary is still used.
+	b.Reset()
+	w.Reset(b)
+	w.Write(record)
+	w.Close()
+	if !bytes.Equal(b.Bytes(), withDict) {
+		t.Error("output after Reset doesn't match")
+	}
+}
+
+func TestGZIPHeader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := GZIPHeader{
+		Name:      "Opticks.txt",
+		Comment:   "Isaac Newton, Opticks (1704)",
+		ModTime:   time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Extra:     []byte("AB\x03\x00xyz"),
+		OS:        3,
+		HeaderCRC: true,
+	}
+	b := new(bytes.Buffer)
+	w := NewGZIPWriterHeader(b, 6, h)
+	w.Write(data)
+	w.Close()
+
+	gr, err := gzip.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	if gr.Name != h.Name || gr.Comment != h.Comment || !gr.ModTime.Equal(h.ModTime) || !bytes.Equal(gr.Extra, h.Extra) || gr.OS != h.OS {
+		t.Errorf("got header %+v, want %+v", gr.Header, h)
+	}
+	decompressed, err = ioutil.ReadAll(NewGZIPReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("output from Reader doesn't match")
+	}
+
+	// With a zero ModTime, the output is reproducible.
+	var outputs [2][]byte
+	for i := range outputs {
+		b := new(bytes.Buffer)
+		w := NewGZIPWriterHeader(b, 6, GZIPHeader{OS: 255})
+		w.Write(data[:10000])
+		w.Close()
+		outputs[i] = b.Bytes()
+	}
+	if !bytes.Equal(outputs[0], outputs[1]) {
+		t.Error("output with zero ModTime isn't reproducible")
+	}
+	if mtime := outputs[0][4:8]; !bytes.Equal(mtime, []byte{0, 0, 0, 0}) {
+		t.Errorf("MTIME is %x with zero ModTime", mtime)
+	}
+}
+
+func TestGZIPMultiMember(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = data[:200000]
+
+	b := new(bytes.Buffer)
+	w := NewGZIPWriterHeader(b, 6, GZIPHeader{Name: "log", OS: 255})
+	w.Encoder.(*GZIPEncoder).MultiMember = true
+	// The text repeats itself, so some matches would reach back into the
+	// previous member.
+	var chunks [][]byte
+	for i := 0; i < len(data); i += 30000 {
+		end := i + 30000
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, data[i:end])
+	}
+	var sizes []int
+	for _, c := range chunks {
+		w.Write(c)
+		w.Flush()
+		sizes = append(sizes, b.Len())
+	}
+	w.Close()
+	compressed := b.Bytes()
+	if len(compressed) != sizes[len(sizes)-1] {
+		t.Errorf("Close added %d bytes after the last member", len(compressed)-sizes[len(sizes)-1])
+	}
+
+	// Each member should be decodable on its own.
+	start := 0
+	for i, end := range sizes {
+		gr, err := gzip.NewReader(bytes.NewReader(compressed[start:end]))
+		if err != nil {
+			t.Fatalf("member %d: %v", i, err)
+		}
+		gr.Multistream(false)
+		decompressed, err := ioutil.ReadAll(gr)
+		if err != nil {
+			t.Fatalf("member %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed, chunks[i]) {
+			t.Fatalf("member %d doesn't match", i)
+		}
+		if gr.Name != "log" {
+			t.Errorf("member %d: got name %q", i, gr.Name)
+		}
+		start = end
+	}
+
+	for _, r := range []func() (io.Reader, error){
+		func() (io.Reader, error) { return gzip.NewReader(bytes.NewReader(compressed)) },
+		func() (io.Reader, error) { return NewGZIPReader(bytes.NewReader(compressed)), nil },
+	} {
+		rd, err := r()
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := ioutil.ReadAll(rd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatal("decompressed output doesn't match")
+		}
+	}
+}
+
+func TestBGZF(t *testing.T) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Include some incompressible data, to check that the blocks still fit
+	// in 64 KB.
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+	data := append(append(append([]byte(nil), opticks[:300000]...), random...), opticks[300000:]...)
+
+	for _, blockSize := range []int{BGZFBlockSize, 1 << 17} {
+		b := new(bytes.Buffer)
+		w := NewBGZFWriter(b, 6)
+		w.BlockSize = blockSize
+		w.Write(data)
+		w.Close()
+		compressed := b.Bytes()
+		e := w.Encoder.(*BGZFEncoder)
+
+		gr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := ioutil.ReadAll(gr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("block size %d: decompressed output doesn't match", blockSize)
+		}
+		decompressed, err = ioutil.ReadAll(NewGZIPReader(bytes.NewReader(compressed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("block size %d: output from Reader doesn't match", blockSize)
+		}
+
+		if !bytes.HasSuffix(compressed, bgzfEOF) {
+			t.Errorf("block size %d: no EOF marker", blockSize)
+		}
+
+		// Check each block's BSIZE, and that it can be decompressed
+		// on its own.
+		blocks := e.Blocks()
+		for i, block := range blocks {
+			start := block.CompressedOffset
+			member := compressed[start:]
+			if !bytes.Equal(member[10:16], []byte{6, 0, 'B', 'C', 2, 0}) {
+				t.Fatalf("block %d: missing BC extra field", i)
+			}
+			size := int(member[16]) | int(member[17])<<8 + 1
+			end := int64(len(compressed) - len(bgzfEOF))
+			if i+1 < len(blocks) {
+				end = blocks[i+1].CompressedOffset
+			}
+			if int64(size) != end-start {
+				t.Fatalf("block %d: BSIZE+1 is %d; block is %d bytes", i, size, end-start)
+			}
+			gr, err := gzip.NewReader(bytes.NewReader(member[:size]))
+			if err != nil {
+				t.Fatalf("block %d: %v", i, err)
+			}
+			content, err := ioutil.ReadAll(gr)
+			if err != nil {
+				t.Fatalf("block %d: %v", i, err)
+			}
+			if len(content) > BGZFBlockSize || !bytes.Equal(content, data[block.UncompressedOffset:block.UncompressedOffset+int64(len(content))]) {
+				t.Fatalf("block %d: content doesn't match", i)
+			}
+		}
+
+		for _, offset := range []int64{0, 1000, BGZFBlockSize, 350000, int64(len(data) - 1)} {
+			v := e.VirtualOffset(offset)
+			gr, err := gzip.NewReader(bytes.NewReader(compressed[v>>16:]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			gr.Multistream(false)
+			content, _ := ioutil.ReadAll(gr)
+			if int(v&0xffff) >= len(content) || content[v&0xffff] != data[offset] {
+				t.Errorf("block size %d: wrong virtual offset %x for %d", blockSize, v, offset)
+			}
+		}
+
+		gzi := e.AppendGZI(nil)
+		if n := binary.LittleEndian.Uint64(gzi); n != uint64(len(blocks)-1) || len(gzi) != 8+16*int(n) {
+			t.Errorf("block size %d: .gzi index has %d entries for %d blocks", blockSize, n, len(blocks))
+		}
+	}
+}
@@ -7,24 +7,100 @@ import (
 	"github.com/andybalholm/pack"
 )
 
-func NewGZIPEncoder() pack.Encoder {
-	return &gzipEncoder{
-		f: NewEncoder(),
-	}
+// A GZIPHeader holds the metadata fields of a gzip member header
+// (RFC 1952).
+type GZIPHeader struct {
+	// Name is the name of the original file. Comment is a comment about
+	// the data. They are stored in ISO 8859-1 (Latin-1); characters that
+	// can't be represented are replaced by '?'. They must not contain zero
+	// bytes.
+	Name    string
+	Comment string
+
+	// ModTime is the modification time of the original data. If it is
+	// the zero Time, MTIME is written as 0 (no time available), which
+	// makes the output reproducible.
+	ModTime time.Time
+
+	// Extra is the raw contents of the extra field (a sequence of
+	// subfields, each with a two-byte ID and a two-byte length), limited
+	// to 65535 bytes.
+	Extra []byte
+
+	// OS identifies the type of file system the data came from, using the
+	// codes in RFC 1952 (255 for unknown).
+	OS byte
+
+	// HeaderCRC causes a CRC-16 of the header to be written (FHCRC).
+	HeaderCRC bool
 }
 
-type gzipEncoder struct {
+// A GZIPEncoder implements the pack.Encoder interface, writing in gzip format.
+// The zero value writes a header with no metadata except OS 0 (FAT); use
+// NewGZIPEncoder for the usual defaults.
+type GZIPEncoder struct {
+	// Header is written at the start of each gzip member.
+	Header GZIPHeader
+
+	// MultiMember causes Flush to finish the current gzip member (instead
+	// of writing a sync point), so that the next data starts a new member
+	// with its own header and trailer. Each flush leaves the output as a
+	// complete gzip file, which is useful for logs that are appended to.
+	// Matches that refer back into the previous member are written as
+	// literals, since the decoder starts each member with an empty window.
+	MultiMember bool
+
 	f           pack.Encoder
 	length      uint32
 	crc         uint32
 	wroteHeader bool
+
+	// members is the number of members that have been finished since the
+	// last Reset. pos is the number of bytes in the current member, up to
+	// windowSize.
+	members int
+	pos     int
+
+	matchBuf []pack.Match
+}
+
+// NewGZIPEncoder returns a GZIPEncoder with the current time as ModTime and
+// 255 (unknown) as OS.
+func NewGZIPEncoder() pack.Encoder {
+	return NewGZIPEncoderHeader(GZIPHeader{
+		ModTime: time.Now(),
+		OS:      255,
+	})
+}
+
+// NewGZIPEncoderHeader returns a GZIPEncoder that writes h as the header.
+func NewGZIPEncoderHeader(h GZIPHeader) pack.Encoder {
+	return &GZIPEncoder{
+		Header: h,
+		f:      NewEncoder(),
+	}
+}
+
+func (g *GZIPEncoder) setMaxBlocks(n int) {
+	if s, ok := g.encoder().(blockSplitter); ok {
+		s.setMaxBlocks(n)
+	}
 }
 
-func (g *gzipEncoder) Reset() {
-	g.f.Reset()
+func (g *GZIPEncoder) encoder() pack.Encoder {
+	if g.f == nil {
+		g.f = NewEncoder()
+	}
+	return g.f
+}
+
+func (g *GZIPEncoder) Reset() {
+	g.encoder().Reset()
 	g.length = 0
 	g.crc = 0
 	g.wroteHeader = false
+	g.members = 0
+	g.pos = 0
 }
 
 func appendUint32(dst []byte, n uint32) []byte {
@@ -36,30 +112,174 @@ func appendUint32(dst []byte, n uint32) []byte {
 	)
 }
 
-func (g *gzipEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
+// appendLatin1 appends s to dst in ISO 8859-1, followed by a zero byte.
+func appendLatin1(dst []byte, s string) []byte {
+	for _, r := range s {
+		if r > 0xff {
+			r = '?'
+		}
+		dst = append(dst, byte(r))
+	}
+	return append(dst, 0)
+}
+
+func (g *GZIPEncoder) writeHeader(dst []byte) []byte {
+	const (
+		flagHdrCrc  = 1 << 1
+		flagExtra   = 1 << 2
+		flagName    = 1 << 3
+		flagComment = 1 << 4
+	)
+
+	h := &g.Header
+	var flg byte
+	if h.HeaderCRC {
+		flg |= flagHdrCrc
+	}
+	if h.Extra != nil {
+		flg |= flagExtra
+	}
+	if h.Name != "" {
+		flg |= flagName
+	}
+	if h.Comment != "" {
+		flg |= flagComment
+	}
+	var mtime uint32
+	if !h.ModTime.IsZero() && h.ModTime.Unix() > 0 {
+		mtime = uint32(h.ModTime.Unix())
+	}
+
+	start := len(dst)
+	dst = append(dst,
+		0x1f, 0x8b, // magic number
+		8, // CM = flate
+		flg,
+	)
+	dst = appendUint32(dst, mtime)
+	dst = append(dst,
+		0, // XFL
+		h.OS,
+	)
+	if h.Extra != nil {
+		extra := h.Extra
+		if len(extra) > 0xffff {
+			extra = extra[:0xffff]
+		}
+		dst = append(dst, byte(len(extra)), byte(len(extra)>>8))
+		dst = append(dst, extra...)
+	}
+	if h.Name != "" {
+		dst = appendLatin1(dst, h.Name)
+	}
+	if h.Comment != "" {
+		dst = appendLatin1(dst, h.Comment)
+	}
+	if h.HeaderCRC {
+		crc := crc32.ChecksumIEEE(dst[start:])
+		dst = append(dst, byte(crc), byte(crc>>8))
+	}
+
+	g.length = 0
+	g.crc = 0
+	g.pos = 0
+	g.wroteHeader = true
+	return dst
+}
+
+// writeTrailer finishes the current member.
+func (g *GZIPEncoder) writeTrailer(dst []byte) []byte {
+	dst = appendUint32(dst, g.crc)
+	dst = appendUint32(dst, g.length)
+	g.wroteHeader = false
+	g.members++
+	return dst
+}
+
+func (g *GZIPEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	if !g.wroteHeader {
-		dst = append(dst,
-			0x1f, 0x8b, // magic number
-			8, // CM = flate
-			0, // FLG
-		)
-		dst = appendUint32(dst, uint32(time.Now().Unix()))
-		dst = append(dst,
-			0,   // XFL
-			255, // OS (unspecified)
-		)
-		g.wroteHeader = true
+		if len(src) == 0 && lastBlock && g.members > 0 {
+			// Don't add an empty member after one that was finished
+			// by Flush.
+			return dst
+		}
+		dst = g.writeHeader(dst)
 	}
 
-	dst = g.f.Encode(dst, src, matches, lastBlock)
+	if g.members > 0 && g.pos < windowSize {
+		matches = limitMatchDistance(g.matchBuf[:0], matches, g.pos)
+		g.matchBuf = matches[:0]
+	}
+	dst = g.encoder().Encode(dst, src, matches, lastBlock)
 
 	g.length += uint32(len(src))
 	g.crc = crc32.Update(g.crc, crc32.IEEETable, src)
+	if g.pos < windowSize {
+		g.pos += len(src)
+	}
 
 	if lastBlock {
-		dst = appendUint32(dst, g.crc)
-		dst = appendUint32(dst, g.length)
+		dst = g.writeTrailer(dst)
 	}
 
 	return dst
 }
+
+// limitMatchDistance appends matches to dst, with any match that refers back
+// more than start bytes before src (that is, before the start of the current
+// gzip member) replaced by literals, and returns dst.
+func limitMatchDistance(dst, matches []pack.Match, start int) []pack.Match {
+	pos := start
+	unmatched := 0
+	for _, m := range matches {
+		pos += m.Unmatched
+		unmatched += m.Unmatched
+		if m.Length > 0 && m.Distance > pos {
+			pos += m.Length
+			unmatched += m.Length
+			continue
+		}
+		dst = append(dst, pack.Match{Unmatched: unmatched, Length: m.Length, Distance: m.Distance})
+		pos += m.Length
+		unmatched = 0
+	}
+	if unmatched > 0 {
+		dst = append(dst, pack.Match{Unmatched: unmatched})
+	}
+	return dst
+}
+
+func (g *GZIPEncoder) Limits() pack.Limits {
+	if l, ok := g.encoder().(pack.Limiter); ok {
+		return l.Limits()
+	}
+	return pack.Limits{}
+}
+
+func (g *GZIPEncoder) LiteralCost(b byte) float32 {
+	return g.encoder().(pack.CostModel).LiteralCost(b)
+}
+
+func (g *GZIPEncoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	return g.encoder().(pack.CostModel).MatchCost(m, recent)
+}
+
+// Flush writes a sync point, or if MultiMember is set, finishes the current
+// gzip member.
+func (g *GZIPEncoder) Flush(dst []byte) []byte {
+	if g.MultiMember {
+		if !g.wroteHeader {
+			return dst
+		}
+		dst = g.encoder().Encode(dst, nil, nil, true)
+		return g.writeTrailer(dst)
+	}
+
+	if !g.wroteHeader {
+		dst = g.writeHeader(dst)
+	}
+	if f, ok := g.encoder().(pack.Flusher); ok {
+		dst = f.Flush(dst)
+	}
+	return dst
+}
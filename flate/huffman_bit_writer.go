@@ -75,10 +75,28 @@ type huffmanBitWriter struct {
 	literalEncoding *huffmanEncoder
 	offsetEncoding  *huffmanEncoder
 	codegenEncoding *huffmanEncoder
+
+	// estimated costs in bits, for LiteralCost and MatchCost
+	literalCost [maxNumLit]float32
+	offsetCost  [offsetCodeCount]float32
+
+	// maxBlocks controls how writeBlocks splits the data from one call to
+	// Encode into blocks: 0 for a quick search for places where the
+	// statistics change, 1 for no splitting, or more for a thorough search
+	// that produces at most maxBlocks blocks. The other fields are scratch
+	// space for writeBlocks.
+	maxBlocks    int
+	splitEntries []pack.Match
+	splitBounds  []int
+	splitPos     []int
+	splitHist    []int32
+	splits       []int
+	splitSizes   []int
+	splitDone    []bool
 }
 
 func NewEncoder() pack.Encoder {
-	return &huffmanBitWriter{
+	w := &huffmanBitWriter{
 		literalFreq:     make([]int32, maxNumLit),
 		offsetFreq:      make([]int32, offsetCodeCount),
 		codegen:         make([]uint8, maxNumLit+offsetCodeCount+1),
@@ -86,10 +104,23 @@ func NewEncoder() pack.Encoder {
 		codegenEncoding: newHuffmanEncoder(codegenCodeCount),
 		offsetEncoding:  newHuffmanEncoder(offsetCodeCount),
 	}
+	w.setCosts(fixedLiteralEncoding, fixedOffsetEncoding)
+	return w
+}
+
+// Limits returns the limits on match length and distance in the DEFLATE
+// format.
+func (w *huffmanBitWriter) Limits() pack.Limits {
+	return pack.Limits{
+		MinLength:   baseMatchLength,
+		MaxLength:   maxMatchLength,
+		MaxDistance: 32768,
+	}
 }
 
 func (w *huffmanBitWriter) Reset() {
 	w.bits, w.nbits = 0, 0
+	w.setCosts(fixedLiteralEncoding, fixedOffsetEncoding)
 }
 
 func (w *huffmanBitWriter) flush() {
@@ -360,6 +391,13 @@ func (w *huffmanBitWriter) writeFixedHeader(isEof bool) {
 // writeBlock will write a block of tokens with the smallest encoding.
 func (w *huffmanBitWriter) writeBlock(matches []pack.Match, eof bool, input []byte) {
 	numLiterals, numOffsets := w.makeStatistics(matches, input)
+	w.writeCountedBlock(numLiterals, numOffsets, matches, eof, input)
+}
+
+// writeCountedBlock is like writeBlock, but it uses the statistics and codes
+// that are already in w, from makeStatistics or generateEncodings.
+func (w *huffmanBitWriter) writeCountedBlock(numLiterals, numOffsets int, matches []pack.Match, eof bool, input []byte) {
+	w.setCosts(w.literalEncoding, w.offsetEncoding)
 
 	var extraBits int
 	storedSize, storable := w.storedSize(input)
@@ -368,14 +406,7 @@ func (w *huffmanBitWriter) writeBlock(matches []pack.Match, eof bool, input []by
 		// the length of offset fields (which will be the same for both fixed
 		// and dynamic encoding), if we need to compare those two encodings
 		// against stored encoding.
-		for lengthCode := lengthCodesStart + 8; lengthCode < numLiterals; lengthCode++ {
-			// First eight length codes have extra size = 0.
-			extraBits += int(w.literalFreq[lengthCode]) * int(lengthExtraBits[lengthCode-lengthCodesStart])
-		}
-		for offsetCode := 4; offsetCode < numOffsets; offsetCode++ {
-			// First four offset codes have extra size = 0.
-			extraBits += int(w.offsetFreq[offsetCode]) * int(offsetExtraBits[offsetCode])
-		}
+		extraBits = w.extraBits(numLiterals, numOffsets)
 	}
 
 	// Figure out smallest code.
@@ -445,7 +476,13 @@ func (w *huffmanBitWriter) makeStatistics(matches []pack.Match, input []byte) (n
 		pos += m.Length
 	}
 	w.literalFreq[endBlockMarker]++
+	return w.generateEncodings()
+}
 
+// generateEncodings generates literalEncoding and offsetEncoding from
+// literalFreq and offsetFreq, and returns the number of literal and offset
+// codes that are needed.
+func (w *huffmanBitWriter) generateEncodings() (numLiterals, numOffsets int) {
 	// get the number of literals
 	numLiterals = len(w.literalFreq)
 	for w.literalFreq[numLiterals-1] == 0 {
@@ -506,7 +543,7 @@ func (w *huffmanBitWriter) writeTokens(matches []pack.Match, input []byte, leCod
 func (w *huffmanBitWriter) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	w.dst = dst
 
-	w.writeBlock(matches, lastBlock, src)
+	w.writeBlocks(matches, lastBlock, src)
 	if lastBlock {
 		w.flush()
 	}
@@ -515,3 +552,17 @@ func (w *huffmanBitWriter) Encode(dst []byte, src []byte, matches []pack.Match,
 	w.dst = nil
 	return dst
 }
+
+// Flush writes an empty stored block, which aligns the output to a byte
+// boundary so that all the data encoded so far can be decoded. This is the
+// same sync marker that compress/flate's Writer.Flush uses.
+func (w *huffmanBitWriter) Flush(dst []byte) []byte {
+	w.dst = dst
+
+	w.writeStoredHeader(0, false)
+	w.flush()
+
+	dst = w.dst
+	w.dst = nil
+	return dst
+}
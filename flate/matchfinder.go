@@ -92,6 +92,8 @@ type compressor struct {
 	sync          bool // requesting flush
 	byteAvailable bool // if true, still need to process window[index-1].
 	unmatched     int  // unmatched bytes to output with the next match
+
+	dictionary []byte
 }
 
 func (d *compressor) fillDeflate(b []byte) int {
@@ -436,6 +438,33 @@ func (d *compressor) Reset() {
 	s.ii = 0
 	s.maxInsertIndex = 0
 	d.unmatched = 0
+	if len(d.dictionary) > 0 {
+		d.fillWindow(d.dictionary)
+	}
+}
+
+// SetDictionary sets a dictionary to prime the compressor with.
+// Only the last 32 KB of it can be used.
+func (d *compressor) SetDictionary(dict []byte) {
+	if len(dict) > windowSize {
+		dict = dict[len(dict)-windowSize:]
+	}
+	d.dictionary = append(d.dictionary[:0], dict...)
+	d.Reset()
+}
+
+// fillWindow copies b into the (empty) window, and adds it to the hash
+// chains.
+func (d *compressor) fillWindow(b []byte) {
+	s := d.state
+	n := copy(d.window, b)
+	for i := 0; i+minMatchLength <= n; i++ {
+		h := hash4(d.window[i:]) & hashMask
+		s.hashPrev[i&windowMask] = s.hashHead[h]
+		s.hashHead[h] = uint32(i + s.hashOffset)
+	}
+	s.index, d.windowEnd = n, n
+	d.blockStart = n
 }
 
 // matchLen returns the maximum length.
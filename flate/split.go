@@ -0,0 +1,317 @@
+package flate
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// extraBits returns the number of extra bits needed for the lengths and
+// offsets counted in literalFreq and offsetFreq.
+func (w *huffmanBitWriter) extraBits(numLiterals, numOffsets int) int {
+	var extraBits int
+	for lengthCode := lengthCodesStart + 8; lengthCode < numLiterals; lengthCode++ {
+		// First eight length codes have extra size = 0.
+		extraBits += int(w.literalFreq[lengthCode]) * int(lengthExtraBits[lengthCode-lengthCodesStart])
+	}
+	for offsetCode := 4; offsetCode < numOffsets; offsetCode++ {
+		// First four offset codes have extra size = 0.
+		extraBits += int(w.offsetFreq[offsetCode]) * int(offsetExtraBits[offsetCode])
+	}
+	return extraBits
+}
+
+// blockSize returns the number of bits that writeBlock would use to write
+// matches as a single block: the smallest of the fixed, dynamic, and stored
+// sizes. It leaves the statistics and the dynamic Huffman codes for the
+// block in w.
+func (w *huffmanBitWriter) blockSize(matches []pack.Match, input []byte) int {
+	numLiterals, numOffsets := w.makeStatistics(matches, input)
+	extraBits := w.extraBits(numLiterals, numOffsets)
+	size := w.fixedSize(extraBits)
+
+	w.generateCodegen(numLiterals, numOffsets, w.literalEncoding, w.offsetEncoding)
+	w.codegenEncoding.generate(w.codegenFreq[:], 7)
+	if dynamicSize, _ := w.dynamicSize(w.literalEncoding, w.offsetEncoding, extraBits); dynamicSize < size {
+		size = dynamicSize
+	}
+
+	if storedSize, storable := w.storedSize(input); storable && storedSize < size {
+		size = storedSize
+	}
+	return size
+}
+
+// maxSplitLiterals is the longest run of unmatched bytes that is kept in one
+// piece by the thorough search for places to split a block.
+const maxSplitLiterals = 1024
+
+const (
+	// splitSegment is the spacing of the places where the quick search
+	// (maxBlocks == 0) considers splitting a block.
+	splitSegment = 4096
+
+	// maxSplitSegments is the largest number of segments the quick search
+	// divides a block into; in larger blocks, the segments are longer.
+	maxSplitSegments = 64
+
+	// histSize is the number of symbol counts per segment boundary in
+	// splitHist: literal/length codes followed by offset codes.
+	histSize = maxNumLit + offsetCodeCount
+)
+
+// writeBlocks writes matches as one or more blocks, splitting them at the
+// places where that makes the output smallest (see maxBlocks).
+//
+// The thorough search uses the exact size of each candidate block. The
+// quick search only considers splitting every splitSegment bytes or so, and
+// it estimates the sizes with estimateBits, from the symbol counts at each
+// of those places.
+func (w *huffmanBitWriter) writeBlocks(matches []pack.Match, eof bool, input []byte) {
+	if w.maxBlocks == 1 || w.maxBlocks == 0 && len(input) < 2*splitSegment {
+		w.writeBlock(matches, eof, input)
+		return
+	}
+
+	// bounds holds the indexes in entries where a block may start or end,
+	// and pos holds the corresponding positions in input. size(start, end)
+	// returns the size of a block going from bounds[start] to bounds[end].
+	entries := w.splitEntries[:0]
+	bounds := w.splitBounds[:0]
+	pos := w.splitPos[:0]
+	var size func(start, end int) int
+	var hist []int32
+	maxBlocks := w.maxBlocks
+
+	if maxBlocks > 1 {
+		// Any entry can start a block. Long runs of unmatched bytes are
+		// broken up, so that a block can be split even where there are few
+		// matches.
+		p := 0
+		for _, m := range matches {
+			for m.Unmatched > maxSplitLiterals {
+				bounds = append(bounds, len(entries))
+				pos = append(pos, p)
+				entries = append(entries, pack.Match{Unmatched: maxSplitLiterals})
+				m.Unmatched -= maxSplitLiterals
+				p += maxSplitLiterals
+			}
+			bounds = append(bounds, len(entries))
+			pos = append(pos, p)
+			entries = append(entries, m)
+			p += m.Unmatched + m.Length
+		}
+		bounds = append(bounds, len(entries))
+		pos = append(pos, p)
+
+		size = func(start, end int) int {
+			return w.blockSize(entries[bounds[start]:bounds[end]], input[pos[start]:pos[end]])
+		}
+	} else {
+		// A block can start at the first entry at or after each multiple of
+		// segment. Runs of unmatched bytes are split there. hist holds the
+		// symbol counts up to each bound.
+		segment := splitSegment
+		if n := len(input) / maxSplitSegments; n > segment {
+			segment = n
+		}
+		hist = w.splitHist[:0]
+		var counts [histSize]int32
+		p, next := 0, 0
+		for _, m := range matches {
+			for {
+				if p >= next {
+					bounds = append(bounds, len(entries))
+					pos = append(pos, p)
+					hist = append(hist, counts[:]...)
+					next = (p/segment + 1) * segment
+				}
+				if p+m.Unmatched <= next {
+					break
+				}
+				for _, c := range input[p:next] {
+					counts[c]++
+				}
+				entries = append(entries, pack.Match{Unmatched: next - p})
+				m.Unmatched -= next - p
+				p = next
+			}
+			for _, c := range input[p : p+m.Unmatched] {
+				counts[c]++
+			}
+			if m.Length > 0 {
+				counts[lengthCodesStart+lengthCode(m.Length)]++
+				counts[maxNumLit+offsetCode(m.Distance)]++
+			}
+			entries = append(entries, m)
+			p += m.Unmatched + m.Length
+		}
+		bounds = append(bounds, len(entries))
+		pos = append(pos, p)
+		hist = append(hist, counts[:]...)
+		w.splitHist = hist
+		maxBlocks = len(bounds) - 1
+
+		size = func(start, end int) int {
+			return estimateBits(hist[start*histSize:(start+1)*histSize], hist[end*histSize:(end+1)*histSize])
+		}
+	}
+	w.splitEntries = entries
+	w.splitBounds = bounds
+	w.splitPos = pos
+
+	// Split the block in two at the best place, and keep doing that with
+	// the largest block that can still be improved by splitting.
+	splits := append(w.splits[:0], 0, len(bounds)-1)
+	sizes := append(w.splitSizes[:0], size(0, len(bounds)-1))
+	done := append(w.splitDone[:0], false)
+	for len(splits)-1 < maxBlocks {
+		best := -1
+		for i := range done {
+			if !done[i] && (best < 0 || pos[splits[i+1]]-pos[splits[i]] > pos[splits[best+1]]-pos[splits[best]]) {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		start, end := splits[best], splits[best+1]
+		if end-start < 2 {
+			done[best] = true
+			continue
+		}
+		p, cost := findMinimum(func(p int) int {
+			return size(start, p) + size(p, end)
+		}, start+1, end)
+		if cost >= sizes[best] {
+			done[best] = true
+			continue
+		}
+		left, right := size(start, p), size(p, end)
+		splits = append(splits, 0)
+		copy(splits[best+2:], splits[best+1:])
+		splits[best+1] = p
+		sizes = append(sizes, 0)
+		copy(sizes[best+1:], sizes[best:])
+		sizes[best], sizes[best+1] = left, right
+		done = append(done, false)
+		copy(done[best+1:], done[best:])
+	}
+	w.splits = splits
+	w.splitSizes = sizes
+	w.splitDone = done
+
+	for i := 0; i+1 < len(splits); i++ {
+		start, end := splits[i], splits[i+1]
+		blockMatches := entries[bounds[start]:bounds[end]]
+		blockInput := input[pos[start]:pos[end]]
+		last := eof && end == len(bounds)-1
+		if hist == nil {
+			w.writeBlock(blockMatches, last, blockInput)
+			continue
+		}
+		// The symbols have already been counted.
+		a := hist[start*histSize : (start+1)*histSize]
+		b := hist[end*histSize : (end+1)*histSize]
+		for j := range w.literalFreq {
+			w.literalFreq[j] = b[j] - a[j]
+		}
+		w.literalFreq[endBlockMarker] = 1
+		for j := range w.offsetFreq {
+			w.offsetFreq[j] = b[maxNumLit+j] - a[maxNumLit+j]
+		}
+		numLiterals, numOffsets := w.generateEncodings()
+		w.writeCountedBlock(numLiterals, numOffsets, blockMatches, last, blockInput)
+	}
+}
+
+var log2Table = func() (t [256]float64) {
+	for i := 1; i < len(t); i++ {
+		t[i] = math.Log2(float64(i))
+	}
+	return t
+}()
+
+// fastLog2 returns log2(n), using a table for small values.
+func fastLog2(n int32) float64 {
+	if n < int32(len(log2Table)) {
+		return log2Table[n]
+	}
+	return math.Log2(float64(n))
+}
+
+// estimateBits estimates the size of a dynamic block holding the symbols
+// counted in b but not in a (two entries of splitHist): their entropy, the
+// extra bits, and a rough allowance of 5 bits for each symbol that is used,
+// for the header.
+func estimateBits(a, b []int32) int {
+	const headerBits = 3 + 5 + 5 + 4 + 19*3
+	bits := float64(headerBits)
+	for _, r := range [][2]int{{0, maxNumLit}, {maxNumLit, histSize}} {
+		var total int32
+		for i := r[0]; i < r[1]; i++ {
+			if n := b[i] - a[i]; n > 0 {
+				total += n
+				bits += 5 - float64(n)*fastLog2(n)
+			}
+		}
+		if total > 0 {
+			bits += float64(total) * fastLog2(total)
+		}
+	}
+
+	var extraBits int
+	for i := lengthCodesStart + 8; i < maxNumLit; i++ {
+		extraBits += int(b[i]-a[i]) * int(lengthExtraBits[i-lengthCodesStart])
+	}
+	for i := 4; i < offsetCodeCount; i++ {
+		extraBits += int(b[maxNumLit+i]-a[maxNumLit+i]) * int(offsetExtraBits[i])
+	}
+	return int(bits) + extraBits
+}
+
+// findMinimum returns the position in [start, end) where f is smallest, and
+// the value there. For a small range, it tries every position. For a larger
+// one, it samples a few evenly-spaced positions, narrows the range to the
+// neighborhood of the best one, and repeats, so it may find a local minimum.
+func findMinimum(f func(int) int, start, end int) (pos, value int) {
+	if end-start < 1024 {
+		pos, value = start, f(start)
+		for i := start + 1; i < end; i++ {
+			if v := f(i); v < value {
+				pos, value = i, v
+			}
+		}
+		return pos, value
+	}
+
+	const samples = 9
+	var p, v [samples]int
+	pos = start
+	value = -1
+	for end-start > samples {
+		best := 0
+		for i := range p {
+			p[i] = start + (i+1)*((end-start)/(samples+1))
+			v[i] = f(p[i])
+			if v[i] < v[best] {
+				best = i
+			}
+		}
+		if value >= 0 && v[best] > value {
+			break
+		}
+		if best > 0 {
+			start = p[best-1]
+		}
+		if best < samples-1 {
+			end = p[best+1]
+		}
+		pos, value = p[best], v[best]
+	}
+	return pos, value
+}
+
+func (w *huffmanBitWriter) setMaxBlocks(n int) {
+	w.maxBlocks = n
+}
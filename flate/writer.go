@@ -8,20 +8,53 @@ import (
 )
 
 // NewWriter returns a new pack.Writer that compresses data at the given level,
-// in flate encoding. Levels 1–9 are available; levels outside this range will
-// be replaced with the closest level available.
+// in flate encoding. Levels 1–12 are available; levels outside this range will
+// be replaced with the closest level available. Levels 10–12 are very slow,
+// but they compress better than level 9 (see Zopfli).
 func NewWriter(w io.Writer, level int) *pack.Writer {
 	return newWriter(w, level, NewEncoder())
 }
 
 // NewGZIPWriter returns a new pack.Writer that compresses data at the given
-// level, in gzip encoding. Levels 1–9 are available; levels outside this range
+// level, in gzip encoding. Levels 1–12 are available; levels outside this range
 // will be replaced by the closest level available.
 func NewGZIPWriter(w io.Writer, level int) *pack.Writer {
 	return newWriter(w, level, NewGZIPEncoder())
 }
 
+// NewGZIPWriterHeader is like NewGZIPWriter, but it writes h as the gzip
+// header.
+func NewGZIPWriterHeader(w io.Writer, level int, h GZIPHeader) *pack.Writer {
+	return newWriter(w, level, NewGZIPEncoderHeader(h))
+}
+
+// NewZlibWriter returns a new pack.Writer that compresses data at the given
+// level, in zlib encoding. Levels 1–12 are available; levels outside this range
+// will be replaced by the closest level available.
+func NewZlibWriter(w io.Writer, level int) *pack.Writer {
+	return newWriter(w, level, NewZlibEncoder(level))
+}
+
+// NewBGZFWriter returns a new pack.Writer that compresses data at the given
+// level, in BGZF format. Levels 1–12 are available; levels outside this range
+// will be replaced by the closest level available. To build an index, use the
+// Blocks or VirtualOffset method of w.Encoder.(*BGZFEncoder).
+func NewBGZFWriter(w io.Writer, level int) *pack.Writer {
+	pw := newWriter(w, level, NewBGZFEncoder())
+	pw.BlockSize = BGZFBlockSize
+	return pw
+}
+
+// A blockSplitter is an Encoder whose search for places to split blocks can
+// be changed (see huffmanBitWriter.maxBlocks).
+type blockSplitter interface {
+	setMaxBlocks(n int)
+}
+
 func newWriter(w io.Writer, level int, e pack.Encoder) *pack.Writer {
+	if s, ok := e.(blockSplitter); ok && level >= 10 {
+		s.setMaxBlocks(15)
+	}
 	return &pack.Writer{
 		Dest:        w,
 		MatchFinder: NewMatchFinder(level),
@@ -34,8 +67,8 @@ func NewMatchFinder(level int) pack.MatchFinder {
 	if level < 1 {
 		level = 1
 	}
-	if level > 9 {
-		level = 9
+	if level > 12 {
+		level = 12
 	}
 
 	var h brotli.Hasher
@@ -53,6 +86,12 @@ func NewMatchFinder(level int) pack.MatchFinder {
 		c := new(compressor)
 		c.init(level)
 		return c
+	case 10:
+		return &Zopfli{Iterations: 5, SearchLen: 256}
+	case 11:
+		return &Zopfli{Iterations: 15, SearchLen: 1024}
+	case 12:
+		return &Zopfli{Iterations: 30, SearchLen: 4096}
 	}
 
 	return &brotli.MatchFinder{
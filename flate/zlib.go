@@ -0,0 +1,133 @@
+package flate
+
+import "github.com/andybalholm/pack"
+
+// NewZlibEncoder returns an Encoder that writes data in zlib format
+// (RFC 1950). The level doesn't affect the compressed data (that depends on
+// the MatchFinder); it is only recorded in the header as a hint of how much
+// effort went into compressing it.
+//
+// The Encoder implements pack.DictionaryEncoder, so a preset dictionary can
+// be set with pack.Writer.SetDictionary. Its checksum is written in the
+// header, so that the decoder can check that it has the right one.
+func NewZlibEncoder(level int) pack.Encoder {
+	return &zlibEncoder{
+		f:     NewEncoder(),
+		level: level,
+	}
+}
+
+type zlibEncoder struct {
+	f           pack.Encoder
+	level       int
+	adler       uint32
+	wroteHeader bool
+
+	hasDict bool
+	dictID  uint32
+}
+
+func (z *zlibEncoder) Reset() {
+	z.f.Reset()
+	z.adler = 1
+	z.wroteHeader = false
+}
+
+func (z *zlibEncoder) setMaxBlocks(n int) {
+	if s, ok := z.f.(blockSplitter); ok {
+		s.setMaxBlocks(n)
+	}
+}
+
+// SetDictionary sets a preset dictionary for the following streams, or
+// stops using one if dict is nil. It returns the part of dict that DEFLATE
+// can refer back to: the last 32 KB.
+func (z *zlibEncoder) SetDictionary(dict []byte) (content []byte, err error) {
+	z.hasDict = dict != nil
+	z.dictID = updateAdler32(1, dict)
+	if len(dict) > windowSize {
+		dict = dict[len(dict)-windowSize:]
+	}
+	return dict, nil
+}
+
+func appendUint32BE(dst []byte, n uint32) []byte {
+	return append(dst,
+		byte(n>>24),
+		byte(n>>16),
+		byte(n>>8),
+		byte(n),
+	)
+}
+
+func (z *zlibEncoder) writeHeader(dst []byte) []byte {
+	// CMF: CM = 8 (DEFLATE), CINFO = 7 (32 KB window).
+	const cmf = 0x78
+
+	var flevel byte
+	switch {
+	case z.level <= 1:
+		flevel = 0 // fastest
+	case z.level <= 5:
+		flevel = 1 // fast
+	case z.level == 6:
+		flevel = 2 // default
+	default:
+		flevel = 3 // maximum compression
+	}
+	flg := flevel << 6
+	if z.hasDict {
+		flg |= 0x20 // FDICT
+	}
+	// FCHECK makes the header a multiple of 31.
+	flg += byte(31 - (cmf<<8+uint(flg))%31)
+
+	dst = append(dst, cmf, flg)
+	if z.hasDict {
+		dst = appendUint32BE(dst, z.dictID)
+	}
+	z.adler = 1
+	z.wroteHeader = true
+	return dst
+}
+
+func (z *zlibEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
+	if !z.wroteHeader {
+		dst = z.writeHeader(dst)
+	}
+
+	dst = z.f.Encode(dst, src, matches, lastBlock)
+
+	z.adler = updateAdler32(z.adler, src)
+
+	if lastBlock {
+		dst = appendUint32BE(dst, z.adler)
+	}
+
+	return dst
+}
+
+func (z *zlibEncoder) Limits() pack.Limits {
+	if l, ok := z.f.(pack.Limiter); ok {
+		return l.Limits()
+	}
+	return pack.Limits{}
+}
+
+func (z *zlibEncoder) LiteralCost(b byte) float32 {
+	return z.f.(pack.CostModel).LiteralCost(b)
+}
+
+func (z *zlibEncoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	return z.f.(pack.CostModel).MatchCost(m, recent)
+}
+
+func (z *zlibEncoder) Flush(dst []byte) []byte {
+	if !z.wroteHeader {
+		dst = z.writeHeader(dst)
+	}
+	if f, ok := z.f.(pack.Flusher); ok {
+		dst = f.Flush(dst)
+	}
+	return dst
+}
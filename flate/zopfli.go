@@ -0,0 +1,146 @@
+package flate
+
+import "github.com/andybalholm/pack"
+
+// Zopfli is a MatchFinder for maximum compression, using the approach of
+// the Zopfli compressor. It parses each block several times with an
+// OptimalParser: the first time with the costs from the previous block,
+// and then with costs based on the Huffman codes that the previous parse
+// would produce. It keeps whichever parse gives the smallest output.
+//
+// It is very slow, so it is only suitable for data that is compressed once
+// and decompressed many times. The output is ordinary DEFLATE data.
+type Zopfli struct {
+	// Iterations is the maximum number of times each block is parsed.
+	// The default is 15. Parsing stops early if a parse doesn't change
+	// the estimated size.
+	Iterations int
+
+	// SearchLen is how many entries to examine on the hash chain at each
+	// position. The default is 1024.
+	SearchLen int
+
+	chain  pack.HashChain
+	parser zopfliParser
+}
+
+func (z *Zopfli) Reset() {
+	z.chain.Reset()
+	if z.parser.cost != nil {
+		z.parser.cost.Reset()
+	}
+}
+
+// SetDictionary sets a dictionary to prime the hash chains with.
+func (z *Zopfli) SetDictionary(dict []byte) {
+	z.chain.SetDictionary(dict)
+}
+
+// FindMatches looks for matches in src, appends them to dst, and returns dst.
+func (z *Zopfli) FindMatches(dst []pack.Match, src []byte) []pack.Match {
+	z.chain.MaxDistance = 32768
+	z.chain.SearchLen = z.SearchLen
+	if z.chain.SearchLen == 0 {
+		z.chain.SearchLen = 1024
+	}
+	z.parser.iterations = z.Iterations
+	if z.parser.iterations == 0 {
+		z.parser.iterations = 15
+	}
+	z.chain.Parser = &z.parser
+	return z.chain.FindMatches(dst, src)
+}
+
+// A zopfliParser is a Parser that runs an OptimalParser repeatedly,
+// updating the costs from the result each time.
+type zopfliParser struct {
+	iterations int
+
+	// cost is used only for its cost model and size estimates; it never
+	// writes any output.
+	cost    *huffmanBitWriter
+	optimal pack.OptimalParser
+	cache   searchCache
+
+	matches []pack.Match
+	best    []pack.Match
+}
+
+func (p *zopfliParser) Parse(dst []pack.Match, src pack.Searcher, start, end int) []pack.Match {
+	if p.cost == nil {
+		p.cost = NewEncoder().(*huffmanBitWriter)
+	}
+	p.optimal = pack.OptimalParser{
+		Cost:       p.cost,
+		MinLength:  baseMatchLength,
+		MaxLength:  maxMatchLength,
+		NiceLength: maxMatchLength,
+	}
+	p.cache.reset(src, start, end)
+	input := p.cache.History()[start:end]
+
+	bestSize := -1
+	lastSize := -1
+	for i := 0; i < p.iterations; i++ {
+		p.matches = p.optimal.Parse(p.matches[:0], &p.cache, start, end)
+		size := p.cost.blockSize(p.matches, input)
+		if bestSize < 0 || size < bestSize {
+			bestSize = size
+			p.best = append(p.best[:0], p.matches...)
+		}
+		if size == lastSize {
+			break
+		}
+		lastSize = size
+		p.cost.setCosts(p.cost.literalEncoding, p.cost.offsetEncoding)
+	}
+
+	// Start the next block with the costs from the best parse.
+	p.cost.blockSize(p.best, input)
+	p.cost.setCosts(p.cost.literalEncoding, p.cost.offsetEncoding)
+	return append(dst, p.best...)
+}
+
+// A searchCache is a Searcher that remembers the matches another Searcher
+// finds at each position, so that a block can be parsed several times
+// without searching again.
+type searchCache struct {
+	src   pack.Searcher
+	start int
+
+	// spans[pos-start] is the range of matches that were found at pos, or
+	// {-1, -1} if pos hasn't been searched yet.
+	spans   [][2]int32
+	matches []pack.AbsoluteMatch
+}
+
+func (c *searchCache) reset(src pack.Searcher, start, end int) {
+	c.src = src
+	c.start = start
+	c.spans = c.spans[:0]
+	for i := start; i < end; i++ {
+		c.spans = append(c.spans, [2]int32{-1, -1})
+	}
+	c.matches = c.matches[:0]
+}
+
+// History returns the history buffer of the underlying Searcher, which must
+// be a HistorySearcher.
+func (c *searchCache) History() []byte {
+	return c.src.(pack.HistorySearcher).History()
+}
+
+func (c *searchCache) Search(dst []pack.AbsoluteMatch, pos, min, max int) []pack.AbsoluteMatch {
+	i := pos - c.start
+	if i < 0 || i >= len(c.spans) {
+		return c.src.Search(dst, pos, min, max)
+	}
+	if s := c.spans[i]; s[0] >= 0 {
+		return append(dst, c.matches[s[0]:s[1]]...)
+	}
+	n := len(dst)
+	dst = c.src.Search(dst, pos, min, max)
+	c.spans[i] = [2]int32{int32(len(c.matches)), int32(len(c.matches) + len(dst) - n)}
+	c.matches = append(c.matches, dst[n:]...)
+	return dst
+}
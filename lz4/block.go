@@ -2,6 +2,7 @@ package lz4
 
 import (
 	"encoding/binary"
+	"strconv"
 
 	"github.com/andybalholm/pack"
 )
@@ -12,6 +13,15 @@ type BlockEncoder struct{}
 
 func (BlockEncoder) Reset() {}
 
+// Limits returns the limits on match length and distance in the LZ4 block
+// format.
+func (BlockEncoder) Limits() pack.Limits {
+	return pack.Limits{
+		MinLength:   4,
+		MaxDistance: maxDistance,
+	}
+}
+
 func (BlockEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	// Ensure that the block ends with at least 5 literal bytes,
 	// and the last match is at least 12 bytes before the end of the block.
@@ -76,6 +86,128 @@ func appendInt(dst []byte, n int) []byte {
 	return dst
 }
 
+// A CorruptInputError reports the presence of corrupt input at a given offset.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "lz4: corrupt input before offset " + strconv.FormatInt(int64(e), 10)
+}
+
+// A BlockDecoder decompresses data in the LZ4 block format.
+type BlockDecoder struct{}
+
+// Decode decompresses the block in src and appends the result to dst.
+// Matches may refer back to data that is already in dst, so blocks from a
+// frame with linked blocks can be decoded by passing the output from the
+// previous blocks as dst.
+func (BlockDecoder) Decode(dst, src []byte) ([]byte, error) {
+	dst, _, err := decodeBlock(dst, src, nil, 0, false)
+	return dst, err
+}
+
+// DecodeMatches is like Decode, but it also appends the block's sequences
+// to matches, in the same form that a pack.MatchFinder produces.
+func (BlockDecoder) DecodeMatches(dst, src []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	return decodeBlock(dst, src, matches, 0, true)
+}
+
+// decodeBlock decompresses the block in src, appending the data to dst and,
+// if withMatches is true, the sequences to matches. If limit is positive,
+// the block may not decompress to more than limit bytes.
+func decodeBlock(dst, src []byte, matches []pack.Match, limit int, withMatches bool) ([]byte, []pack.Match, error) {
+	start := len(dst)
+	s := 0
+	for {
+		if s >= len(src) {
+			return dst, matches, CorruptInputError(s)
+		}
+		token := src[s]
+		s++
+
+		literals := int(token >> 4)
+		if literals == 15 {
+			n, ok := readInt(src, &s)
+			if !ok {
+				return dst, matches, CorruptInputError(s)
+			}
+			literals += n
+		}
+		if literals > len(src)-s {
+			return dst, matches, CorruptInputError(len(src))
+		}
+		dst = append(dst, src[s:s+literals]...)
+		s += literals
+
+		if s == len(src) {
+			// The last sequence has only literals.
+			if withMatches && literals > 0 {
+				matches = append(matches, pack.Match{Unmatched: literals})
+			}
+			break
+		}
+
+		if s+2 > len(src) {
+			return dst, matches, CorruptInputError(len(src))
+		}
+		offset := int(binary.LittleEndian.Uint16(src[s:]))
+		s += 2
+		if offset == 0 || offset > len(dst) {
+			return dst, matches, CorruptInputError(s)
+		}
+
+		length := int(token&15) + 4
+		if length == 19 {
+			n, ok := readInt(src, &s)
+			if !ok {
+				return dst, matches, CorruptInputError(s)
+			}
+			length += n
+		}
+		if limit > 0 && len(dst)+length-start > limit {
+			return dst, matches, CorruptInputError(s)
+		}
+
+		pos := len(dst) - offset
+		for remaining := length; remaining > 0; {
+			// When the match overlaps the data it is producing, copy it in
+			// chunks, each of which is a whole number of repetitions.
+			chunk := dst[pos:]
+			if len(chunk) > remaining {
+				chunk = chunk[:remaining]
+			}
+			dst = append(dst, chunk...)
+			remaining -= len(chunk)
+		}
+
+		if withMatches {
+			matches = append(matches, pack.Match{
+				Unmatched: literals,
+				Length:    length,
+				Distance:  offset,
+			})
+		}
+	}
+
+	if limit > 0 && len(dst)-start > limit {
+		return dst, matches, CorruptInputError(len(src))
+	}
+	return dst, matches, nil
+}
+
+// readInt reads an integer in LZ4's variable-length format from src[*s:],
+// and advances *s past it.
+func readInt(src []byte, s *int) (n int, ok bool) {
+	for *s < len(src) {
+		b := src[*s]
+		*s++
+		n += int(b)
+		if b != 255 {
+			return n, true
+		}
+	}
+	return n, false
+}
+
 func Score(m pack.AbsoluteMatch) int {
 	s := m.End - m.Start
 	if s > 18 {
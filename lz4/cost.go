@@ -0,0 +1,37 @@
+package lz4
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// LiteralCost returns 8, since unmatched bytes are stored as they are.
+func (BlockEncoder) LiteralCost(b byte) float32 {
+	return 8
+}
+
+// MatchCost returns the number of bits in the sequence header for m:
+// the token, the offset, and any extra bytes for the lengths.
+// LZ4 has no repeat-distance codes, so recent is ignored.
+func (BlockEncoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	if m.Length < 4 || m.Distance < 1 || m.Distance > maxDistance {
+		return float32(math.Inf(1))
+	}
+	n := 3
+	if m.Unmatched > 14 {
+		n += 1 + (m.Unmatched-15)/255
+	}
+	if m.Length > 18 {
+		n += 1 + (m.Length-19)/255
+	}
+	return float32(8 * n)
+}
+
+func (f *FrameEncoder) LiteralCost(b byte) float32 {
+	return BlockEncoder{}.LiteralCost(b)
+}
+
+func (f *FrameEncoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	return BlockEncoder{}.MatchCost(m, recent)
+}
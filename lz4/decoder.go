@@ -0,0 +1,408 @@
+package lz4
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+
+	"github.com/andybalholm/pack"
+	"github.com/pierrec/xxHash/xxHash32"
+)
+
+var (
+	// ErrChecksum is returned when a block or frame checksum doesn't match
+	// the data.
+	ErrChecksum = errors.New("lz4: invalid checksum")
+
+	// ErrHeader is returned when a frame header is invalid.
+	ErrHeader = errors.New("lz4: invalid header")
+
+	// ErrDictionary is returned when a frame header specifies a dictionary
+	// ID, and no dictionary has been set with SetDictionary.
+	ErrDictionary = errors.New("lz4: missing dictionary")
+)
+
+const (
+	frameMagic       = 0x184D2204
+	legacyFrameMagic = 0x184C2102
+
+	// skippable frames have magic numbers 0x184D2A50 to 0x184D2A5F.
+	skippableFrameMagic = 0x184D2A50
+	skippableFrameMask  = 0xFFFFFFF0
+
+	legacyBlockSize = 8 << 20
+	// Block sizes in a legacy frame are limited to the compress bound of
+	// legacyBlockSize. Anything bigger is the magic number of the next frame.
+	legacyMaxCompressedSize = legacyBlockSize + legacyBlockSize/255 + 16
+
+	windowSize = 64 << 10
+)
+
+// FLG byte flags
+const (
+	flagIndependentBlocks = 1 << 5
+	flagBlockChecksum     = 1 << 4
+	flagContentSize       = 1 << 3
+	flagContentChecksum   = 1 << 2
+	flagDictionaryID      = 1 << 0
+)
+
+// A Decoder reads an LZ4 stream one block at a time. Along with the
+// decompressed data, it returns the sequences that the data was encoded
+// with, in the same form that a pack.MatchFinder produces, so that the data
+// can be analyzed or re-encoded with a different Encoder.
+//
+// The stream may contain several frames, including skippable frames and
+// frames in the legacy format.
+type Decoder struct {
+	r       io.Reader
+	roffset int64
+	buf     [8]byte
+
+	// history holds the decompressed data from previous blocks that may be
+	// referenced by matches, followed by the current block.
+	history    []byte
+	block      []byte
+	dictionary []byte
+
+	// state of the current frame
+	inFrame      bool
+	legacy       bool
+	flags        byte
+	blockMaxSize int
+	contentSize  uint64
+	hasher       hash.Hash32
+	frameBytes   uint64
+
+	err error
+}
+
+// NewDecoder returns a Decoder that reads LZ4 data from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.Reset(r)
+	return d
+}
+
+// Reset discards the Decoder's state and prepares it to read a new stream
+// from r.
+func (d *Decoder) Reset(r io.Reader) {
+	d.r = r
+	d.roffset = 0
+	d.history = d.history[:0]
+	d.inFrame = false
+	d.err = nil
+}
+
+// SetDictionary sets the preset dictionary that the data was compressed
+// with. It is used at the start of each frame (and each block, if the blocks
+// are independent), except in legacy frames. The frame headers may or may not
+// identify the dictionary; if they do, the ID isn't checked.
+func (d *Decoder) SetDictionary(dict []byte) {
+	if len(dict) > windowSize {
+		dict = dict[len(dict)-windowSize:]
+	}
+	d.dictionary = append(d.dictionary[:0], dict...)
+}
+
+// NextBlock decodes the next block. It appends the decompressed data to
+// dst and the block's sequences to matches, and returns the updated slices.
+// The matches cover exactly the data appended to dst, but when the frame
+// has linked blocks, they may refer back to data from previous blocks.
+// At the end of the stream, NextBlock returns io.EOF.
+func (d *Decoder) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if d.err != nil {
+		return dst, matches, d.err
+	}
+
+	for {
+		if !d.inFrame {
+			// A clean EOF between frames is the end of the stream.
+			magic, err := d.readUint32()
+			if err != nil {
+				d.err = err
+				return dst, matches, err
+			}
+			if d.err = d.readFrameHeader(magic); d.err != nil {
+				return dst, matches, d.err
+			}
+			continue
+		}
+
+		var blockData []byte
+		var ok bool
+		blockData, matches, ok, d.err = d.nextBlock(matches)
+		if d.err != nil {
+			return dst, matches, d.err
+		}
+		if ok {
+			return append(dst, blockData...), matches, nil
+		}
+	}
+}
+
+// readUint32 reads a little-endian uint32 from the input. If there is no
+// more input, it returns io.EOF.
+func (d *Decoder) readUint32() (uint32, error) {
+	n, err := io.ReadFull(d.r, d.buf[:4])
+	d.roffset += int64(n)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(d.buf[:4]), nil
+}
+
+func (d *Decoder) readFull(buf []byte) error {
+	n, err := io.ReadFull(d.r, buf)
+	d.roffset += int64(n)
+	return noEOF(err)
+}
+
+// noEOF returns err, unless err == io.EOF, in which case it returns io.ErrUnexpectedEOF.
+func noEOF(e error) error {
+	if e == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return e
+}
+
+// readFrameHeader reads the frame header that follows magic, skipping any
+// skippable frames.
+func (d *Decoder) readFrameHeader(magic uint32) error {
+	for magic&skippableFrameMask == skippableFrameMagic {
+		size, err := d.readUint32()
+		if err != nil {
+			return noEOF(err)
+		}
+		n, err := io.CopyN(ioutil.Discard, d.r, int64(size))
+		d.roffset += n
+		if err != nil {
+			return noEOF(err)
+		}
+		magic, err = d.readUint32()
+		if err != nil {
+			return err
+		}
+	}
+
+	d.history = d.history[:0]
+	d.frameBytes = 0
+
+	switch magic {
+	case legacyFrameMagic:
+		d.inFrame = true
+		d.legacy = true
+		d.flags = flagIndependentBlocks
+		d.blockMaxSize = legacyBlockSize
+		d.hasher = nil
+		return nil
+	case frameMagic:
+	default:
+		return ErrHeader
+	}
+
+	// Frame descriptor: FLG, BD, optional content size and dictionary ID,
+	// and the header checksum.
+	header := d.buf[:2]
+	if err := d.readFull(header); err != nil {
+		return err
+	}
+	flg, bd := header[0], header[1]
+	if flg>>6 != 1 || flg&2 != 0 || bd&0x8f != 0 {
+		return ErrHeader
+	}
+	switch bd >> 4 {
+	case 4:
+		d.blockMaxSize = 64 << 10
+	case 5:
+		d.blockMaxSize = 256 << 10
+	case 6:
+		d.blockMaxSize = 1 << 20
+	case 7:
+		d.blockMaxSize = 4 << 20
+	default:
+		return ErrHeader
+	}
+
+	hasher := xxHash32.New(0)
+	hasher.Write(header)
+	if flg&flagContentSize != 0 {
+		if err := d.readFull(d.buf[:8]); err != nil {
+			return err
+		}
+		hasher.Write(d.buf[:8])
+		d.contentSize = binary.LittleEndian.Uint64(d.buf[:8])
+	}
+	if flg&flagDictionaryID != 0 {
+		if err := d.readFull(d.buf[:4]); err != nil {
+			return err
+		}
+		hasher.Write(d.buf[:4])
+		if binary.LittleEndian.Uint32(d.buf[:4]) != 0 && len(d.dictionary) == 0 {
+			return ErrDictionary
+		}
+	}
+
+	if err := d.readFull(d.buf[:1]); err != nil {
+		return err
+	}
+	if d.buf[0] != byte(hasher.Sum32()>>8) {
+		return ErrHeader
+	}
+
+	d.inFrame = true
+	d.legacy = false
+	d.flags = flg
+	d.history = append(d.history, d.dictionary...)
+	d.hasher = nil
+	if flg&flagContentChecksum != 0 {
+		d.hasher = xxHash32.New(0)
+	}
+	return nil
+}
+
+// nextBlock reads and decodes the next data block of the current frame, and
+// returns the decompressed data. If the frame ended instead, it returns
+// ok == false.
+func (d *Decoder) nextBlock(matches []pack.Match) (data []byte, m []pack.Match, ok bool, err error) {
+	size, err := d.readUint32()
+	if d.legacy {
+		if err == io.EOF {
+			// A legacy frame ends at the end of the stream,
+			d.inFrame = false
+			return nil, matches, false, io.EOF
+		}
+		if err != nil {
+			return nil, matches, false, err
+		}
+		if size > legacyMaxCompressedSize {
+			// or at the start of another frame.
+			d.inFrame = false
+			return nil, matches, false, d.readFrameHeader(size)
+		}
+	} else if err != nil {
+		return nil, matches, false, noEOF(err)
+	}
+
+	if size == 0 && !d.legacy {
+		return nil, matches, false, d.finishFrame()
+	}
+
+	uncompressed := size&(1<<31) != 0 && !d.legacy
+	size &^= 1 << 31
+	if int(size) > d.blockMaxSize && !d.legacy {
+		return nil, matches, false, CorruptInputError(d.roffset)
+	}
+
+	blockStart := d.roffset
+	if cap(d.block) < int(size) {
+		d.block = make([]byte, size)
+	}
+	d.block = d.block[:size]
+	if err := d.readFull(d.block); err != nil {
+		return nil, matches, false, err
+	}
+
+	if d.flags&flagBlockChecksum != 0 {
+		checksum, err := d.readUint32()
+		if err != nil {
+			return nil, matches, false, noEOF(err)
+		}
+		if checksum != xxHash32.Checksum(d.block, 0) {
+			return nil, matches, false, ErrChecksum
+		}
+	}
+
+	if d.flags&flagIndependentBlocks != 0 {
+		d.history = d.history[:0]
+		if !d.legacy {
+			d.history = append(d.history, d.dictionary...)
+		}
+	} else if len(d.history) > 2*windowSize {
+		n := copy(d.history, d.history[len(d.history)-windowSize:])
+		d.history = d.history[:n]
+	}
+	start := len(d.history)
+
+	if uncompressed {
+		d.history = append(d.history, d.block...)
+		if len(d.block) > 0 {
+			matches = append(matches, pack.Match{Unmatched: len(d.block)})
+		}
+	} else {
+		d.history, matches, err = decodeBlock(d.history, d.block, matches, d.blockMaxSize, true)
+		if err != nil {
+			return nil, matches, false, CorruptInputError(blockStart + int64(err.(CorruptInputError)))
+		}
+	}
+
+	data = d.history[start:]
+	d.frameBytes += uint64(len(data))
+	if d.hasher != nil {
+		d.hasher.Write(data)
+	}
+	return data, matches, true, nil
+}
+
+// finishFrame checks the content size and checksum at the end of a frame.
+func (d *Decoder) finishFrame() error {
+	d.inFrame = false
+	if d.flags&flagContentSize != 0 && d.frameBytes != d.contentSize {
+		return CorruptInputError(d.roffset)
+	}
+	if d.hasher != nil {
+		checksum, err := d.readUint32()
+		if err != nil {
+			return noEOF(err)
+		}
+		if checksum != d.hasher.Sum32() {
+			return ErrChecksum
+		}
+	}
+	return nil
+}
+
+// A Reader decompresses an LZ4 stream.
+type Reader struct {
+	d       *Decoder
+	buf     []byte
+	pos     int
+	matches []pack.Match
+	err     error
+}
+
+// NewReader returns a Reader that decompresses LZ4 data from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: NewDecoder(r)}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.matches, r.err = r.d.NextBlock(r.buf[:0], r.matches[:0])
+		r.pos = 0
+	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// Reset discards the Reader's state and prepares it to read a new stream
+// from src.
+func (r *Reader) Reset(src io.Reader) {
+	r.d.Reset(src)
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.matches = r.matches[:0]
+	r.err = nil
+}
+
+// SetDictionary sets the preset dictionary that the data was compressed
+// with. See Decoder.SetDictionary.
+func (r *Reader) SetDictionary(dict []byte) {
+	r.d.SetDictionary(dict)
+}
@@ -11,6 +11,11 @@ import (
 // A FrameEncoder implements the pack.Encoder interface,
 // writing in the LZ4 frame format.
 type FrameEncoder struct {
+	// DictionaryID identifies the preset dictionary (if any) that the
+	// frames are compressed with. If it is not zero, it is written in the
+	// frame header.
+	DictionaryID uint32
+
 	hasher      hash.Hash32
 	blockBuffer []byte
 }
@@ -19,12 +24,43 @@ func (f *FrameEncoder) Reset() {
 	f.hasher = nil
 }
 
+func (f *FrameEncoder) writeHeader(dst []byte) []byte {
+	f.hasher = xxHash32.New(0)
+	dst = binary.LittleEndian.AppendUint32(dst, frameMagic)
+	// Frame descriptor for linked blocks, content checksum enabled, and 4-MB
+	// blocks.
+	start := len(dst)
+	dst = append(dst, 0x40|flagContentChecksum, 0x70)
+	if f.DictionaryID != 0 {
+		dst[start] |= flagDictionaryID
+		dst = binary.LittleEndian.AppendUint32(dst, f.DictionaryID)
+	}
+	return append(dst, byte(xxHash32.Checksum(dst[start:], 0)>>8))
+}
+
+// SetDictionary returns the part of dict that an LZ4 frame can refer back to:
+// the last 64 KB. LZ4 frames don't carry any other information about the
+// dictionary, except for DictionaryID.
+func (f *FrameEncoder) SetDictionary(dict []byte) (content []byte, err error) {
+	if len(dict) > windowSize {
+		dict = dict[len(dict)-windowSize:]
+	}
+	return dict, nil
+}
+
+// Limits returns the limits on match length and distance in the LZ4 block
+// format, and the 4 MB block size that the frame header specifies.
+func (f *FrameEncoder) Limits() pack.Limits {
+	return pack.Limits{
+		BlockSize:   4 << 20,
+		MinLength:   4,
+		MaxDistance: maxDistance,
+	}
+}
+
 func (f *FrameEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	if f.hasher == nil {
-		f.hasher = xxHash32.New(0)
-		dst = binary.LittleEndian.AppendUint32(dst, 0x184D2204)
-		// Frame header for content checksum enabled, and 4-MB blocks.
-		dst = append(dst, 0x44, 0x70, 0x1d)
+		dst = f.writeHeader(dst)
 	}
 
 	var be BlockEncoder
@@ -41,3 +77,12 @@ func (f *FrameEncoder) Encode(dst []byte, src []byte, matches []pack.Match, last
 
 	return dst
 }
+
+// Flush makes sure that the frame header has been written. Each call to Encode
+// produces a complete LZ4 block, so no other sync marker is needed.
+func (f *FrameEncoder) Flush(dst []byte) []byte {
+	if f.hasher == nil {
+		dst = f.writeHeader(dst)
+	}
+	return dst
+}
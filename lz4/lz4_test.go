@@ -2,13 +2,16 @@ package lz4
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"testing"
 
 	"github.com/andybalholm/pack"
 	"github.com/andybalholm/pack/flate"
 	"github.com/pierrec/lz4/v4"
+	"github.com/pierrec/xxHash/xxHash32"
 )
 
 func TestBlockEncode(t *testing.T) {
@@ -203,6 +206,18 @@ func TestDualHashOverlap(t *testing.T) {
 	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.DualHash{Parser: &pack.OverlapParser{}})
 }
 
+func TestOptimalParser(t *testing.T) {
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.HashChain{SearchLen: 100, Parser: &pack.OptimalParser{}})
+}
+
+func TestSingleHashOptimal(t *testing.T) {
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.SingleHash{Parser: &pack.OptimalParser{}})
+}
+
+func TestDualHashOptimal(t *testing.T) {
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.DualHash{Parser: &pack.OptimalParser{}})
+}
+
 func benchmark(b *testing.B, filename string, m pack.MatchFinder) {
 	b.StopTimer()
 	b.ReportAllocs()
@@ -325,3 +340,307 @@ func BenchmarkEncodeDualHashLazy(b *testing.B) {
 func BenchmarkEncodeDualHashOverlap(b *testing.B) {
 	benchmark(b, "../testdata/Isaac.Newton-Opticks.txt", &pack.DualHash{Parser: &pack.OverlapParser{}})
 }
+
+func TestFlush(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: &BestSpeed{},
+		Encoder:     &FrameEncoder{},
+		BlockSize:   65536,
+	}
+	w.Write(data[:100000])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	partial := make([]byte, 100000)
+	if _, err := io.ReadFull(lz4.NewReader(bytes.NewReader(b.Bytes())), partial); err != nil {
+		t.Fatalf("error decompressing flushed data: %v", err)
+	}
+	if !bytes.Equal(partial, data[:100000]) {
+		t.Fatal("flushed output doesn't match")
+	}
+
+	w.Write(data[100000:])
+	w.Close()
+	decompressed, err := io.ReadAll(lz4.NewReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
+func TestBlockDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compressed := make([]byte, lz4.CompressBlockBound(len(data)))
+	n, err := lz4.CompressBlock(data, compressed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed = compressed[:n]
+
+	var bd BlockDecoder
+	decompressed, err := bd.Decode(nil, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	// Re-encode the block, using the matches from the decoder.
+	decompressed, matches, err := bd.DecodeMatches(nil, compressed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	var be BlockEncoder
+	if reencoded := be.Encode(nil, data, matches, true); !bytes.Equal(reencoded, compressed) {
+		t.Fatal("re-encoded block doesn't match")
+	}
+
+	if _, err := bd.Decode(nil, compressed[:len(compressed)-10]); err == nil {
+		t.Fatal("no error for truncated block")
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Our own output has linked blocks.
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: &BestSpeed{},
+		Encoder:     &FrameEncoder{},
+		BlockSize:   65536,
+	}
+	w.Write(data)
+	w.Close()
+
+	d := NewDecoder(bytes.NewReader(b.Bytes()))
+	var decompressed []byte
+	var matches []pack.Match
+	var reencoded []byte
+	fe := new(FrameEncoder)
+	for {
+		start := len(decompressed)
+		decompressed, matches, err = d.NextBlock(decompressed, matches[:0])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		reencoded = fe.Encode(reencoded, decompressed[start:], matches, false)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	reencoded = fe.Encode(reencoded, nil, nil, true)
+	decompressed, err = io.ReadAll(lz4.NewReader(bytes.NewReader(reencoded)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("re-encoded output doesn't match")
+	}
+}
+
+func TestReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Some incompressible data, to get uncompressed blocks.
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	for _, options := range [][]lz4.Option{
+		nil,
+		{lz4.BlockSizeOption(lz4.Block64Kb)},
+		{lz4.BlockSizeOption(lz4.Block256Kb), lz4.SizeOption(uint64(len(data) + len(random)))},
+		{lz4.BlockSizeOption(lz4.Block1Mb), lz4.ChecksumOption(false)},
+	} {
+		b := new(bytes.Buffer)
+		w := lz4.NewWriter(b)
+		if err := w.Apply(options...); err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+		w.Write(random)
+		w.Close()
+
+		// Add a skippable frame, and a second frame with our own output.
+		b.Write([]byte{0x5a, 0x2a, 0x4d, 0x18, 3, 0, 0, 0, 'a', 'b', 'c'})
+		var mf BestSpeed
+		var fe FrameEncoder
+		b.Write(fe.Encode(nil, data[:1000], mf.FindMatches(nil, data[:1000]), true))
+
+		want := append(append(append([]byte(nil), data...), random...), data[:1000]...)
+		decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(b.Bytes())))
+		if err != nil {
+			t.Fatalf("error decompressing with options %v: %v", options, err)
+		}
+		if !bytes.Equal(decompressed, want) {
+			t.Fatalf("decompressed output doesn't match with options %v", options)
+		}
+	}
+
+	var mf BestSpeed
+	var fe FrameEncoder
+	compressed := fe.Encode(nil, data, mf.FindMatches(nil, data), true)
+	compressed[len(compressed)-2]++
+	_, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != ErrChecksum {
+		t.Fatalf("got %v with bad checksum, want %v", err, ErrChecksum)
+	}
+
+	// A legacy frame, followed by a regular one.
+	compressed = binary.LittleEndian.AppendUint32(nil, 0x184C2102)
+	buf := make([]byte, lz4.CompressBlockBound(len(data)))
+	n, err := lz4.CompressBlock(data, buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed = binary.LittleEndian.AppendUint32(compressed, uint32(n))
+	compressed = append(compressed, buf[:n]...)
+	mf.Reset()
+	fe.Reset()
+	compressed = fe.Encode(compressed, data[:1000], mf.FindMatches(nil, data[:1000]), true)
+	decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatalf("error decompressing legacy frame: %v", err)
+	}
+	if !bytes.Equal(decompressed, append(data[:len(data):len(data)], data[:1000]...)) {
+		t.Fatal("decompressed output doesn't match for legacy frame")
+	}
+
+	// pierrec/lz4 computes block checksums over the uncompressed data, so
+	// build a frame with block checksums by hand.
+	compressed = binary.LittleEndian.AppendUint32(nil, 0x184D2204)
+	compressed = append(compressed, 0x70, 0x40)
+	compressed = append(compressed, byte(xxHash32.Checksum(compressed[4:], 0)>>8))
+	for i := 0; i < len(data); i += 65536 {
+		block := data[i:]
+		if len(block) > 65536 {
+			block = block[:65536]
+		}
+		buf := make([]byte, lz4.CompressBlockBound(len(block)))
+		n, err := lz4.CompressBlock(block, buf, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compressed = binary.LittleEndian.AppendUint32(compressed, uint32(n))
+		compressed = append(compressed, buf[:n]...)
+		compressed = binary.LittleEndian.AppendUint32(compressed, xxHash32.Checksum(buf[:n], 0))
+	}
+	compressed = append(compressed, 0, 0, 0, 0)
+	decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatalf("error decompressing frame with block checksums: %v", err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match with block checksums")
+	}
+	compressed[len(compressed)-10]++
+	_, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != ErrChecksum {
+		t.Fatalf("got %v with bad block checksum, want %v", err, ErrChecksum)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	w := lz4.NewWriter(buf)
+	w.Write(opticks)
+	w.Close()
+	compressed := buf.Bytes()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(opticks)))
+	r := NewReader(bytes.NewReader(compressed))
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dict := data[:1<<16]
+	var records [][]byte
+	for i := 200000; i < 250000; i += 1000 {
+		records = append(records, data[i:i+1000])
+	}
+
+	// Blocks, checked with the reference decoder
+	mf := &pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}}
+	mf.SetDictionary(dict)
+	for i, r := range records {
+		var be BlockEncoder
+		compressed := be.Encode(nil, r, mf.FindMatches(nil, r), true)
+		mf.Reset()
+		decompressed := make([]byte, len(r))
+		n, err := lz4.UncompressBlockWithDict(compressed, decompressed, dict)
+		if err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed[:n], r) {
+			t.Fatalf("record %d: decompressed output doesn't match", i)
+		}
+	}
+
+	// Frames, with the dictionary ID in the header
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: &pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}},
+		Encoder:     &FrameEncoder{DictionaryID: 42},
+		BlockSize:   1 << 16,
+	}
+	if err := w.SetDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range records[:3] {
+		w.Reset(b)
+		w.Write(rec)
+		w.Close()
+	}
+	r := NewReader(bytes.NewReader(b.Bytes()))
+	r.SetDictionary(dict)
+	decompressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, bytes.Join(records[:3], nil)) {
+		t.Fatal("decompressed frames don't match")
+	}
+	if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(b.Bytes()))); err != ErrDictionary {
+		t.Fatalf("got %v without the dictionary, want %v", err, ErrDictionary)
+	}
+}
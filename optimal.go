@@ -0,0 +1,254 @@
+package pack
+
+import "math/bits"
+
+// A CostModel estimates how many bits it will take to encode unmatched bytes
+// and matches in a particular compression format, so that a Parser can
+// choose the cheapest way to encode the data.
+//
+// The Encoders in the subpackages implement CostModel, basing their estimates
+// on the statistics of the blocks they have already encoded. Since those
+// estimates change as each block is encoded, an Encoder shouldn't be used as
+// a CostModel by a MatchFinder running in a different goroutine (as happens
+// when a Writer's Concurrency is greater than 1).
+type CostModel interface {
+	// LiteralCost returns the estimated number of bits needed to encode b
+	// as an unmatched byte.
+	LiteralCost(b byte) float32
+
+	// MatchCost returns the estimated number of bits needed to encode m,
+	// not counting the unmatched bytes themselves (but including the cost
+	// of encoding their count, if the format does that separately).
+	// recent holds the distances of the previous matches, most recent first,
+	// for formats that have special codes for repeated distances.
+	// Unused entries are 0. If m can't be encoded at all, MatchCost
+	// returns +Inf.
+	MatchCost(m Match, recent [4]int) float32
+}
+
+// A HistorySearcher is a Searcher that gives access to the data it searches.
+type HistorySearcher interface {
+	Searcher
+
+	// History returns the buffer that the positions passed to Search refer
+	// to.
+	History() []byte
+}
+
+// An OptimalParser chooses matches by finding the cheapest path through the
+// data, according to a CostModel. It searches for matches at every position,
+// so it is much slower than the other parsers, but it can compress better.
+//
+// If the Searcher implements HistorySearcher, the cost of each unmatched
+// byte is estimated individually; otherwise the average cost of all byte
+// values is used.
+type OptimalParser struct {
+	// Cost is the cost model used to compare different ways of encoding the
+	// data. If it is nil, a rough estimate that doesn't depend on the
+	// compression format is used.
+	Cost CostModel
+
+	// MinLength is the shortest match that will be used. The default is 4.
+	MinLength int
+
+	// MaxLength is the longest match that will be used. If it is zero,
+	// there is no limit.
+	MaxLength int
+
+	// NiceLength is the match length that is considered good enough to use
+	// without looking for alternatives inside it. This keeps the parser
+	// from slowing down dramatically on highly repetitive data.
+	// The default is 256.
+	NiceLength int
+
+	matchCache []AbsoluteMatch
+	nodes      []optimalNode
+}
+
+// An optimalNode records the cheapest known way to reach a position.
+type optimalNode struct {
+	cost float32
+
+	// length and distance of the match that ends here,
+	// or 0 if the previous byte was unmatched
+	length   int32
+	distance int32
+
+	// unmatched is the number of unmatched bytes since the last match.
+	unmatched int32
+
+	recent [4]int
+}
+
+func (p *OptimalParser) Parse(dst []Match, src Searcher, start, end int) []Match {
+	cost := p.Cost
+	if cost == nil {
+		cost = defaultCostModel{}
+	}
+	minLength := p.MinLength
+	if minLength == 0 {
+		minLength = 4
+	}
+	niceLength := p.NiceLength
+	if niceLength == 0 {
+		niceLength = 256
+	}
+
+	var history []byte
+	if hs, ok := src.(HistorySearcher); ok {
+		history = hs.History()
+	}
+	var averageLiteralCost float32
+	if history == nil {
+		for i := 0; i < 256; i++ {
+			averageLiteralCost += cost.LiteralCost(byte(i))
+		}
+		averageLiteralCost /= 256
+	}
+
+	n := end - start
+	if cap(p.nodes) < n+1 {
+		p.nodes = make([]optimalNode, n+1)
+	}
+	nodes := p.nodes[:n+1]
+	const unreached = float32(1e30)
+	for i := range nodes {
+		nodes[i] = optimalNode{cost: unreached}
+	}
+	nodes[0].cost = 0
+
+	for i := 0; i < n; i++ {
+		node := &nodes[i]
+
+		// Try an unmatched byte.
+		literalCost := averageLiteralCost
+		if history != nil {
+			literalCost = cost.LiteralCost(history[start+i])
+		}
+		if c := node.cost + literalCost; c < nodes[i+1].cost {
+			nodes[i+1] = optimalNode{
+				cost:      c,
+				unmatched: node.unmatched + 1,
+				recent:    node.recent,
+			}
+		}
+
+		// Try the matches that start here.
+		pos := start + i
+		matches := src.Search(p.matchCache[:0], pos, pos, end)
+		p.matchCache = matches
+		sortByDistance(matches)
+
+		// For each length, use the closest match that is long enough.
+		covered := minLength - 1
+		longest := 0
+		for _, m := range matches {
+			if m.Start != pos {
+				// The Searcher shouldn't extend matches backward past min,
+				// but just in case...
+				m.Match += pos - m.Start
+				m.Start = pos
+			}
+			length := m.End - m.Start
+			if p.MaxLength > 0 && length > p.MaxLength {
+				length = p.MaxLength
+			}
+			distance := m.Start - m.Match
+			for l := covered + 1; l <= length; l++ {
+				c := node.cost + cost.MatchCost(Match{
+					Unmatched: int(node.unmatched),
+					Length:    l,
+					Distance:  distance,
+				}, node.recent)
+				if c < nodes[i+l].cost {
+					nodes[i+l] = optimalNode{
+						cost:     c,
+						length:   int32(l),
+						distance: int32(distance),
+						recent:   pushDistance(node.recent, distance),
+					}
+				}
+			}
+			if length > covered {
+				covered = length
+			}
+			if length > longest {
+				longest = length
+			}
+		}
+
+		if longest >= niceLength {
+			// Take the long match without looking for alternatives inside it.
+			i += longest - 1
+		}
+	}
+
+	// Trace the cheapest path back from the end. Until the path is put in
+	// the right order, Unmatched holds the position where each match starts.
+	pathStart := len(dst)
+	for i := n; i > 0; {
+		node := nodes[i]
+		if node.length == 0 {
+			i--
+			continue
+		}
+		i -= int(node.length)
+		dst = append(dst, Match{
+			Unmatched: i,
+			Length:    int(node.length),
+			Distance:  int(node.distance),
+		})
+	}
+	path := dst[pathStart:]
+	for a, b := 0, len(path)-1; a < b; a, b = a+1, b-1 {
+		path[a], path[b] = path[b], path[a]
+	}
+
+	nextEmit := 0
+	for j := range path {
+		matchStart := path[j].Unmatched
+		path[j].Unmatched = matchStart - nextEmit
+		nextEmit = matchStart + path[j].Length
+	}
+	if nextEmit < n {
+		dst = append(dst, Match{Unmatched: n - nextEmit})
+	}
+	return dst
+}
+
+// sortByDistance sorts matches by distance, closest first.
+func sortByDistance(matches []AbsoluteMatch) {
+	// Insertion sort, since there are usually only a few matches.
+	for i := 1; i < len(matches); i++ {
+		for j := i; j > 0 && matches[j].Start-matches[j].Match < matches[j-1].Start-matches[j-1].Match; j-- {
+			matches[j], matches[j-1] = matches[j-1], matches[j]
+		}
+	}
+}
+
+// pushDistance returns recent with distance added at the front, unless it
+// was already the most recent distance.
+func pushDistance(recent [4]int, distance int) [4]int {
+	if recent[0] == distance {
+		return recent
+	}
+	return [4]int{distance, recent[0], recent[1], recent[2]}
+}
+
+// defaultCostModel is a rough estimate of the cost of literals and matches,
+// for use when no format-specific CostModel is available. It assumes that
+// lengths and distances are coded with a logarithmic prefix code plus extra
+// bits, as in most LZ77 formats.
+type defaultCostModel struct{}
+
+func (defaultCostModel) LiteralCost(b byte) float32 {
+	return 8
+}
+
+func (defaultCostModel) MatchCost(m Match, recent [4]int) float32 {
+	lengthBits := bits.Len(uint(m.Length))
+	if m.Distance == recent[0] {
+		return float32(4 + 2*lengthBits)
+	}
+	return float32(8 + 2*lengthBits + 2*bits.Len(uint(m.Distance)))
+}
@@ -40,6 +40,25 @@ type Encoder interface {
 	Reset()
 }
 
+// A Flusher is an Encoder that can mark a sync point in the compressed stream,
+// so that a decoder can decompress all the data encoded so far without
+// waiting for the end of the stream.
+type Flusher interface {
+	// Flush appends whatever is needed to make the data already encoded
+	// decodable to dst, and returns dst.
+	Flush(dst []byte) []byte
+}
+
+// An ErrorReporter is an Encoder that can detect input that it can't encode
+// correctly, such as a different amount of data than its stream header
+// declared. Since Encode doesn't return an error, Writer and Transcode check
+// Err after each block, and return the error.
+type ErrorReporter interface {
+	// Err returns the first error that the Encoder has found since it was
+	// Reset, or nil.
+	Err() error
+}
+
 // A Writer uses MatchFinder and Encoder to write compressed data to Dest.
 type Writer struct {
 	Dest        io.Writer
@@ -50,10 +69,31 @@ type Writer struct {
 	// each Write operation will be treated as one block.
 	BlockSize int
 
+	// Concurrency is the number of blocks to search for matches at the same
+	// time. If it is greater than 1 (and BlockSize is not zero), each block
+	// is searched by a separate MatchFinder, created with NewMatchFinder,
+	// in its own goroutine. The Encoder still processes the blocks in order.
+	Concurrency int
+
+	// NewMatchFinder returns a new MatchFinder for parallel compression.
+	// It is required if Concurrency is greater than 1. If MatchFinder is nil,
+	// NewMatchFinder is also used to create it.
+	NewMatchFinder func() MatchFinder
+
+	// HistorySize is the number of bytes from the end of the previous block
+	// that are passed to a parallel MatchFinder before the block itself,
+	// so that it can find matches that cross the block boundary. If it is
+	// zero, each block is searched independently.
+	HistorySize int
+
 	err     error
 	inBuf   []byte
 	outBuf  []byte
 	matches []Match
+
+	workers    []parallelWorker
+	history    []byte
+	dictionary []byte
 }
 
 func (w *Writer) Write(p []byte) (n int, err error) {
@@ -67,8 +107,15 @@ func (w *Writer) Write(p []byte) (n int, err error) {
 
 	w.inBuf = append(w.inBuf, p...)
 	var pos int
-	for pos = 0; pos+w.BlockSize <= len(w.inBuf) && w.err == nil; pos += w.BlockSize {
-		w.writeBlock(w.inBuf[pos:pos+w.BlockSize], false)
+	if w.parallel() {
+		batchSize := w.BlockSize * w.Concurrency
+		for pos = 0; pos+batchSize <= len(w.inBuf) && w.err == nil; pos += batchSize {
+			w.writeParallel(w.inBuf[pos:pos+batchSize], false)
+		}
+	} else {
+		for pos = 0; pos+w.BlockSize <= len(w.inBuf) && w.err == nil; pos += w.BlockSize {
+			w.writeBlock(w.inBuf[pos:pos+w.BlockSize], false)
+		}
 	}
 	if pos > 0 {
 		n := copy(w.inBuf, w.inBuf[pos:])
@@ -79,25 +126,73 @@ func (w *Writer) Write(p []byte) (n int, err error) {
 }
 
 func (w *Writer) writeBlock(p []byte, lastBlock bool) (n int, err error) {
-	w.outBuf = w.outBuf[:0]
+	if w.MatchFinder == nil && w.NewMatchFinder != nil {
+		w.MatchFinder = w.NewMatchFinder()
+	}
 	w.matches = w.MatchFinder.FindMatches(w.matches[:0], p)
-	w.outBuf = w.Encoder.Encode(w.outBuf, p, w.matches, lastBlock)
-	_, w.err = w.Dest.Write(w.outBuf)
+	w.encode(p, w.matches, lastBlock)
 	return len(p), w.err
 }
 
+// encode encodes a block and writes it to w.Dest.
+func (w *Writer) encode(p []byte, matches []Match, lastBlock bool) {
+	w.outBuf = w.Encoder.Encode(w.outBuf[:0], p, matches, lastBlock)
+	if r, ok := w.Encoder.(ErrorReporter); ok {
+		if w.err = r.Err(); w.err != nil {
+			return
+		}
+	}
+	_, w.err = w.Dest.Write(w.outBuf)
+}
+
+// Flush compresses any buffered data, and writes a sync point if the Encoder
+// implements Flusher. After Flush returns, a reader can decode all the data
+// that has been written so far.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if len(w.inBuf) > 0 {
+		if w.parallel() {
+			w.writeParallel(w.inBuf, false)
+		} else {
+			w.writeBlock(w.inBuf, false)
+		}
+		w.inBuf = w.inBuf[:0]
+		if w.err != nil {
+			return w.err
+		}
+	}
+
+	if f, ok := w.Encoder.(Flusher); ok {
+		w.outBuf = f.Flush(w.outBuf[:0])
+		if len(w.outBuf) > 0 {
+			_, w.err = w.Dest.Write(w.outBuf)
+		}
+	}
+	return w.err
+}
+
 func (w *Writer) Close() error {
-	w.writeBlock(w.inBuf, true)
+	if w.parallel() {
+		w.writeParallel(w.inBuf, true)
+	} else {
+		w.writeBlock(w.inBuf, true)
+	}
 	w.inBuf = w.inBuf[:0]
 	return w.err
 }
 
 func (w *Writer) Reset(newDest io.Writer) {
-	w.MatchFinder.Reset()
+	if w.MatchFinder != nil {
+		w.MatchFinder.Reset()
+	}
 	w.Encoder.Reset()
 	w.err = nil
 	w.inBuf = w.inBuf[:0]
 	w.outBuf = w.outBuf[:0]
 	w.matches = w.matches[:0]
+	w.history = append(w.history[:0], w.dictionaryHistory()...)
 	w.Dest = newDest
 }
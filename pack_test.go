@@ -0,0 +1,486 @@
+package pack_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	refbrotli "github.com/andybalholm/brotli"
+	"github.com/andybalholm/pack"
+	"github.com/andybalholm/pack/brotli"
+	pflate "github.com/andybalholm/pack/flate"
+	"github.com/andybalholm/pack/lz4"
+	"github.com/andybalholm/pack/snappy"
+	"github.com/andybalholm/pack/zstd"
+	refsnappy "github.com/golang/snappy"
+	refzstd "github.com/klauspost/compress/zstd"
+	reflz4 "github.com/pierrec/lz4/v4"
+)
+
+// A format describes one of the compression formats in the subpackages, for
+// the tests that run on all of them.
+type format struct {
+	name       string
+	newEncoder func() pack.Encoder
+
+	// The limits on matches, for the parser and MatchFinder.
+	minLength, maxLength, maxDistance int
+
+	// independentBlocks means that matches can't refer to previous blocks.
+	independentBlocks bool
+
+	// decompress decompresses data with a reference implementation.
+	decompress func(compressed []byte) ([]byte, error)
+
+	// decompressDict decompresses data that was compressed with a preset
+	// dictionary, using the subpackage's Reader. It is nil if the format
+	// doesn't support dictionaries.
+	decompressDict func(compressed, dict []byte) ([]byte, error)
+}
+
+var formats = []format{
+	{
+		name:       "brotli",
+		newEncoder: func() pack.Encoder { return &brotli.Encoder{} },
+		decompress: func(c []byte) ([]byte, error) {
+			return ioutil.ReadAll(refbrotli.NewReader(bytes.NewReader(c)))
+		},
+		decompressDict: func(c, dict []byte) ([]byte, error) {
+			r := brotli.NewReader(bytes.NewReader(c))
+			r.SetDictionary(dict)
+			return ioutil.ReadAll(r)
+		},
+	},
+	{
+		name:        "flate",
+		newEncoder:  pflate.NewEncoder,
+		minLength:   3,
+		maxLength:   258,
+		maxDistance: 32768,
+		decompress: func(c []byte) ([]byte, error) {
+			return ioutil.ReadAll(flate.NewReader(bytes.NewReader(c)))
+		},
+		decompressDict: func(c, dict []byte) ([]byte, error) {
+			r := pflate.NewReader(bytes.NewReader(c))
+			r.SetDictionary(dict)
+			return ioutil.ReadAll(r)
+		},
+	},
+	{
+		name:       "lz4",
+		newEncoder: func() pack.Encoder { return &lz4.FrameEncoder{} },
+		decompress: func(c []byte) ([]byte, error) {
+			return ioutil.ReadAll(reflz4.NewReader(bytes.NewReader(c)))
+		},
+		decompressDict: func(c, dict []byte) ([]byte, error) {
+			r := lz4.NewReader(bytes.NewReader(c))
+			r.SetDictionary(dict)
+			return ioutil.ReadAll(r)
+		},
+	},
+	{
+		name:              "snappy",
+		newEncoder:        func() pack.Encoder { return &snappy.Encoder{} },
+		independentBlocks: true,
+		decompress: func(c []byte) ([]byte, error) {
+			return ioutil.ReadAll(refsnappy.NewReader(bytes.NewReader(c)))
+		},
+	},
+	{
+		name:       "zstd",
+		newEncoder: func() pack.Encoder { return &zstd.Encoder{} },
+		minLength:  3,
+		maxLength:  131074,
+		decompress: func(c []byte) ([]byte, error) {
+			r, err := refzstd.NewReader(bytes.NewReader(c))
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return ioutil.ReadAll(r)
+		},
+		decompressDict: func(c, dict []byte) ([]byte, error) {
+			r := zstd.NewReader(bytes.NewReader(c))
+			if err := r.SetDictionary(dict); err != nil {
+				return nil, err
+			}
+			return ioutil.ReadAll(r)
+		},
+	},
+}
+
+// matchFinder returns a MatchFinder that fits f's limits, using p to choose
+// the matches.
+func (f format) matchFinder(p pack.Parser) pack.MatchFinder {
+	var mf pack.MatchFinder = &pack.HashChain{
+		SearchLen:   16,
+		MaxDistance: f.maxDistance,
+		Parser:      p,
+	}
+	if f.independentBlocks {
+		mf = pack.AutoReset{MatchFinder: mf}
+	}
+	return mf
+}
+
+func opticks(t *testing.T) []byte {
+	data, err := ioutil.ReadFile("testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// unpack appends the data described by src and matches to dst, copying the
+// matched bytes the way a decoder would, instead of from src. The matches may
+// refer back to data that is already in dst.
+func unpack(dst, src []byte, matches []pack.Match) ([]byte, error) {
+	pos := 0
+	for i, m := range matches {
+		if pos+m.Unmatched+m.Length > len(src) {
+			return dst, fmt.Errorf("match %d goes past the end of the data", i)
+		}
+		dst = append(dst, src[pos:pos+m.Unmatched]...)
+		pos += m.Unmatched + m.Length
+		if m.Length > 0 && (m.Distance <= 0 || m.Distance > len(dst)) {
+			return dst, fmt.Errorf("match %d has invalid distance %d", i, m.Distance)
+		}
+		for j := 0; j < m.Length; j++ {
+			dst = append(dst, dst[len(dst)-m.Distance])
+		}
+	}
+	return append(dst, src[pos:]...), nil
+}
+
+func TestCostModel(t *testing.T) {
+	data := opticks(t)
+
+	for _, f := range formats {
+		compress := func(e pack.Encoder, cost pack.CostModel) []byte {
+			b := new(bytes.Buffer)
+			w := &pack.Writer{
+				Dest: b,
+				MatchFinder: f.matchFinder(&pack.OptimalParser{
+					Cost:      cost,
+					MinLength: f.minLength,
+					MaxLength: f.maxLength,
+				}),
+				Encoder:   e,
+				BlockSize: 1 << 16,
+			}
+			w.Write(data)
+			w.Close()
+			return b.Bytes()
+		}
+
+		e := f.newEncoder()
+		cm, ok := e.(pack.CostModel)
+		if !ok {
+			t.Errorf("%s: %T doesn't implement CostModel", f.name, e)
+			continue
+		}
+		compressed := compress(e, cm)
+		decompressed, err := f.decompress(compressed)
+		if err != nil {
+			t.Fatalf("%s: %v", f.name, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("%s: decompressed output doesn't match", f.name)
+		}
+
+		withDefault := compress(f.newEncoder(), nil)
+		if len(compressed) >= len(withDefault) {
+			t.Errorf("%s: compressed size with the Encoder's cost model is %d; with the default it is %d", f.name, len(compressed), len(withDefault))
+		}
+	}
+}
+
+// distanceLimit is a CostModel that makes matches farther back than its
+// value impossible to encode.
+type distanceLimit int
+
+func (d distanceLimit) LiteralCost(b byte) float32 {
+	return 8
+}
+
+func (d distanceLimit) MatchCost(m pack.Match, recent [4]int) float32 {
+	if m.Distance > int(d) {
+		return float32(math.Inf(1))
+	}
+	return 24
+}
+
+func TestOptimalParser(t *testing.T) {
+	data := opticks(t)[:100000]
+
+	for i, p := range []*pack.OptimalParser{
+		{},
+		{Cost: distanceLimit(1000), MinLength: 6, MaxLength: 20},
+	} {
+		mf := &pack.HashChain{SearchLen: 16, Parser: p}
+		matches := mf.FindMatches(nil, data)
+		matched := 0
+		for _, m := range matches {
+			if m.Length == 0 {
+				continue
+			}
+			matched += m.Length
+			if m.Length < 4 || m.Length < p.MinLength || p.MaxLength > 0 && m.Length > p.MaxLength {
+				t.Fatalf("parser %d: match length %d is outside the limits", i, m.Length)
+			}
+			if limit, ok := p.Cost.(distanceLimit); ok && m.Distance > int(limit) {
+				t.Fatalf("parser %d: match distance %d has infinite cost", i, m.Distance)
+			}
+		}
+		if matched < len(data)/2 {
+			t.Errorf("parser %d: only %d of %d bytes matched", i, matched, len(data))
+		}
+		unpacked, err := unpack(nil, data, matches)
+		if err != nil {
+			t.Fatalf("parser %d: %v", i, err)
+		}
+		if !bytes.Equal(unpacked, data) {
+			t.Fatalf("parser %d: matches don't reproduce the data", i)
+		}
+	}
+}
+
+func TestTranscode(t *testing.T) {
+	data := opticks(t)
+
+	// Brotli has a much bigger window and longer matches than the other
+	// formats, and snappy needs smaller blocks with no references between
+	// them, so the matches need to be adjusted.
+	gz := new(bytes.Buffer)
+	w := pflate.NewGZIPWriter(gz, 6)
+	w.Write(data)
+	w.Close()
+	br := new(bytes.Buffer)
+	w = brotli.NewWriter(br, 9)
+	w.Write(data)
+	w.Close()
+
+	for _, src := range []struct {
+		name    string
+		decoder func() pack.MatchSource
+	}{
+		{"gzip", func() pack.MatchSource { return pflate.NewGZIPDecoder(bytes.NewReader(gz.Bytes())) }},
+		{"brotli", func() pack.MatchSource { return brotli.NewDecoder(bytes.NewReader(br.Bytes())) }},
+	} {
+		for _, f := range formats {
+			transcoded := new(bytes.Buffer)
+			if err := pack.Transcode(transcoded, f.newEncoder(), src.decoder()); err != nil {
+				t.Fatalf("%s to %s: %v", src.name, f.name, err)
+			}
+			decompressed, err := f.decompress(transcoded.Bytes())
+			if err != nil {
+				t.Fatalf("%s to %s: %v", src.name, f.name, err)
+			}
+			if !bytes.Equal(decompressed, data) {
+				t.Fatalf("%s to %s: transcoded output doesn't match", src.name, f.name)
+			}
+		}
+	}
+}
+
+// blockSource is a MatchSource that returns blocks that have already been
+// compressed.
+type blockSource struct {
+	blocks  [][]byte
+	matches [][]pack.Match
+}
+
+func (s *blockSource) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if len(s.blocks) == 0 {
+		return dst, matches, io.EOF
+	}
+	dst = append(dst, s.blocks[0]...)
+	matches = append(matches, s.matches[0]...)
+	s.blocks, s.matches = s.blocks[1:], s.matches[1:]
+	return dst, matches, nil
+}
+
+// limitedEncoder is an Encoder that checks that the matches it receives fit
+// its limits, and decodes them instead of compressing them.
+type limitedEncoder struct {
+	limits  pack.Limits
+	decoded []byte
+	err     error
+}
+
+func (e *limitedEncoder) Limits() pack.Limits {
+	return e.limits
+}
+
+func (e *limitedEncoder) Reset() {
+	e.decoded = e.decoded[:0]
+	e.err = nil
+}
+
+func (e *limitedEncoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
+	if e.err != nil {
+		return dst
+	}
+	if len(src) > e.limits.BlockSize {
+		e.err = fmt.Errorf("block size %d is over the limit", len(src))
+		return dst
+	}
+	for _, m := range matches {
+		if m.Length == 0 {
+			continue
+		}
+		if m.Length < e.limits.MinLength || m.Length > e.limits.MaxLength || m.Distance > e.limits.MaxDistance {
+			e.err = fmt.Errorf("match %+v is outside the limits", m)
+			return dst
+		}
+	}
+
+	if e.limits.IndependentBlocks {
+		var block []byte
+		block, e.err = unpack(nil, src, matches)
+		e.decoded = append(e.decoded, block...)
+	} else {
+		e.decoded, e.err = unpack(e.decoded, src, matches)
+	}
+	return dst
+}
+
+func TestTranscodeLimits(t *testing.T) {
+	data := opticks(t)[:300000]
+
+	// Break the data into blocks with long, distant matches, which refer
+	// back to previous blocks.
+	src := new(blockSource)
+	mf := &pack.HashChain{SearchLen: 16, Parser: &pack.OptimalParser{MaxLength: 1000}}
+	for i := 0; i < len(data); i += 50000 {
+		block := data[i : i+50000]
+		src.blocks = append(src.blocks, block)
+		src.matches = append(src.matches, mf.FindMatches(nil, block))
+	}
+
+	for _, independent := range []bool{false, true} {
+		e := &limitedEncoder{
+			limits: pack.Limits{
+				BlockSize:         20000,
+				MinLength:         4,
+				MaxLength:         20,
+				MaxDistance:       30000,
+				IndependentBlocks: independent,
+			},
+		}
+		s := *src
+		if err := pack.Transcode(ioutil.Discard, e, &s); err != nil {
+			t.Fatal(err)
+		}
+		if e.err != nil {
+			t.Fatalf("IndependentBlocks %v: %v", independent, e.err)
+		}
+		if !bytes.Equal(e.decoded, data) {
+			t.Fatalf("IndependentBlocks %v: transcoded data doesn't match", independent)
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	data := opticks(t)
+	dict := data[:1<<16]
+	var records [][]byte
+	for i := 200000; i < 250000; i += 1000 {
+		records = append(records, data[i:i+1000])
+	}
+
+	for _, f := range formats {
+		if f.decompressDict == nil {
+			continue
+		}
+		compress := func(dict []byte) (compressed [][]byte, total int) {
+			w := &pack.Writer{
+				MatchFinder: f.matchFinder(&pack.LazyParser{}),
+				Encoder:     f.newEncoder(),
+				BlockSize:   1 << 16,
+			}
+			if dict != nil {
+				if err := w.SetDictionary(dict); err != nil {
+					t.Fatalf("%s: %v", f.name, err)
+				}
+			}
+			for _, r := range records {
+				b := new(bytes.Buffer)
+				w.Reset(b)
+				w.Write(r)
+				w.Close()
+				compressed = append(compressed, b.Bytes())
+				total += b.Len()
+			}
+			return compressed, total
+		}
+
+		withDict, withDictSize := compress(dict)
+		for i, c := range withDict {
+			decompressed, err := f.decompressDict(c, dict)
+			if err != nil {
+				t.Fatalf("%s, record %d: %v", f.name, i, err)
+			}
+			if !bytes.Equal(decompressed, records[i]) {
+				t.Fatalf("%s, record %d: decompressed output doesn't match", f.name, i)
+			}
+		}
+
+		_, withoutSize := compress(nil)
+		if withDictSize >= withoutSize {
+			t.Errorf("%s: compressed size with dictionary is %d; without it is %d", f.name, withDictSize, withoutSize)
+		}
+	}
+}
+
+func TestDictionaryMatchFinders(t *testing.T) {
+	data := opticks(t)
+	var records [][]byte
+	for i := 400000; i < 450000; i += 1000 {
+		records = append(records, data[i:i+1000])
+	}
+
+	// The large dictionary is more than the MatchFinders keep in their
+	// history buffers, so they need to trim it.
+	for _, dict := range [][]byte{data[400000-1<<15 : 400000], data[:400000]} {
+		for _, mf := range []pack.DictionaryMatchFinder{
+			&pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}},
+			&pack.SingleHash{Parser: &pack.GreedyParser{}},
+			&pack.DualHash{Parser: &pack.OverlapParser{}},
+			pflate.NewMatchFinder(6).(pack.DictionaryMatchFinder),
+			&brotli.MatchFinder{Hasher: &brotli.H5{BlockBits: 4, BucketBits: 14}, MaxHistory: 1 << 17, MinHistory: 1 << 15},
+		} {
+			testDictionaryMatchFinder(t, mf, dict, records)
+		}
+	}
+}
+
+func testDictionaryMatchFinder(t *testing.T, mf pack.DictionaryMatchFinder, dict []byte, records [][]byte) {
+	var withDict, without int
+	for i, r := range records {
+		mf.SetDictionary(nil)
+		for _, m := range mf.FindMatches(nil, r) {
+			without += m.Unmatched
+		}
+
+		mf.SetDictionary(dict)
+		matches := mf.FindMatches(nil, r)
+		for _, m := range matches {
+			withDict += m.Unmatched
+		}
+		unpacked, err := unpack(append([]byte(nil), dict...), r, matches)
+		if err != nil {
+			t.Fatalf("%T, %d-byte dictionary, record %d: %v", mf, len(dict), i, err)
+		}
+		if !bytes.Equal(unpacked[len(dict):], r) {
+			t.Fatalf("%T, %d-byte dictionary, record %d: matches don't reproduce the data", mf, len(dict), i)
+		}
+	}
+	if withDict >= without {
+		t.Errorf("%T, %d-byte dictionary: %d unmatched bytes with it; %d without", mf, len(dict), withDict, without)
+	}
+}
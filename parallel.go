@@ -0,0 +1,84 @@
+package pack
+
+// A parallelWorker holds the state for searching one block of a batch.
+type parallelWorker struct {
+	mf      MatchFinder
+	matches []Match
+	done    chan struct{}
+}
+
+func (w *Writer) parallel() bool {
+	return w.Concurrency > 1 && w.BlockSize > 0
+}
+
+// writeParallel splits p into blocks of BlockSize bytes (at most Concurrency
+// of them), finds the matches in each block concurrently, and then encodes and
+// writes the blocks in order.
+func (w *Writer) writeParallel(p []byte, lastBlock bool) {
+	n := (len(p) + w.BlockSize - 1) / w.BlockSize
+	if n == 0 {
+		// Even an empty final block needs to go through the Encoder.
+		n = 1
+	}
+	for len(w.workers) < n {
+		w.workers = append(w.workers, parallelWorker{
+			mf: w.NewMatchFinder(),
+		})
+	}
+
+	for i := 0; i < n; i++ {
+		start := i * w.BlockSize
+		end := start + w.BlockSize
+		if end > len(p) {
+			end = len(p)
+		}
+
+		var history []byte
+		if w.HistorySize > 0 {
+			if i == 0 {
+				history = w.history
+			} else {
+				history = p[start-w.BlockSize : start]
+				if len(history) > w.HistorySize {
+					history = history[len(history)-w.HistorySize:]
+				}
+			}
+		}
+
+		wk := &w.workers[i]
+		wk.done = make(chan struct{})
+		go func(block []byte) {
+			wk.mf.Reset()
+			if len(history) > 0 {
+				wk.matches = wk.mf.FindMatches(wk.matches[:0], history)
+			}
+			wk.matches = wk.mf.FindMatches(wk.matches[:0], block)
+			close(wk.done)
+		}(p[start:end])
+	}
+
+	for i := 0; i < n; i++ {
+		wk := &w.workers[i]
+		<-wk.done
+		if w.err != nil {
+			// Keep waiting, so that no goroutines are still using the
+			// workers after we return.
+			continue
+		}
+
+		start := i * w.BlockSize
+		end := start + w.BlockSize
+		if end > len(p) {
+			end = len(p)
+		}
+		w.encode(p[start:end], wk.matches, lastBlock && i == n-1)
+	}
+
+	if w.HistorySize > 0 {
+		tail := p[(n-1)*w.BlockSize:]
+		if len(tail) > w.HistorySize {
+			tail = tail[len(tail)-w.HistorySize:]
+		}
+		w.history = append(w.history[:0], tail...)
+	}
+}
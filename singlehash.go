@@ -13,12 +13,16 @@ type SingleHash struct {
 
 	table [maxTableSize]uint32
 
-	history []byte
+	history    []byte
+	dictionary []byte
 }
 
 func (q *SingleHash) Reset() {
 	q.table = [maxTableSize]uint32{}
 	q.history = q.history[:0]
+	if len(q.dictionary) > 0 {
+		q.loadDictionary()
+	}
 }
 
 // FindMatches looks for matches in src, appends them to dst, and returns dst.
@@ -51,6 +55,11 @@ func (q *SingleHash) FindMatches(dst []Match, src []byte) []Match {
 	return q.Parser.Parse(dst, q, nextEmit, len(src))
 }
 
+// History returns the buffer that Search's positions refer to.
+func (q *SingleHash) History() []byte {
+	return q.history
+}
+
 func (q *SingleHash) Search(dst []AbsoluteMatch, pos, min, max int) []AbsoluteMatch {
 	if pos+4 > len(q.history) {
 		return dst
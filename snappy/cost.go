@@ -0,0 +1,50 @@
+package snappy
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// LiteralCost returns 8, since unmatched bytes are stored as they are.
+func (e *Encoder) LiteralCost(b byte) float32 {
+	return 8
+}
+
+// MatchCost returns the number of bits needed for the copy element(s) that
+// encode m, plus the tag for the literal element before it, if there is one.
+// Snappy has no repeat-distance codes, so recent is ignored.
+func (e *Encoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	if m.Length < 4 || m.Distance < 1 || m.Distance > 65535 {
+		return float32(math.Inf(1))
+	}
+
+	n := 0
+	switch {
+	case m.Unmatched == 0:
+	case m.Unmatched <= 60:
+		n = 1
+	case m.Unmatched <= 1<<8:
+		n = 2
+	default:
+		n = 3
+	}
+
+	// This follows the same logic as appendCopy.
+	length := m.Length
+	for length >= 68 {
+		n += 3
+		length -= 64
+	}
+	if length > 64 {
+		n += 3
+		length -= 60
+	}
+	if length >= 12 || m.Distance >= 2048 {
+		n += 3
+	} else {
+		n += 2
+	}
+
+	return float32(8 * n)
+}
@@ -0,0 +1,303 @@
+package snappy
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/andybalholm/pack"
+)
+
+var (
+	// ErrChecksum is returned when a chunk's checksum doesn't match the
+	// decompressed data.
+	ErrChecksum = errors.New("snappy: invalid checksum")
+
+	// ErrHeader is returned when the stream doesn't start with a valid
+	// stream identifier.
+	ErrHeader = errors.New("snappy: invalid stream identifier")
+
+	// ErrUnsupported is returned when the stream contains a reserved
+	// unskippable chunk.
+	ErrUnsupported = errors.New("snappy: unsupported chunk type")
+)
+
+// A CorruptInputError reports the presence of corrupt input at a given offset.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "snappy: corrupt input before offset " + strconv.FormatInt(int64(e), 10)
+}
+
+// chunk types
+const (
+	chunkCompressed   = 0x00
+	chunkUncompressed = 0x01
+	chunkPadding      = 0xfe
+	chunkStreamID     = 0xff
+)
+
+const maxBlockSize = 65536
+
+// A Decoder reads a stream in the Snappy framing format one chunk at a time.
+// Along with the decompressed data, it returns the copies that each chunk was
+// encoded with, in the same form that a pack.MatchFinder produces, so that
+// the data can be analyzed or re-encoded with a different Encoder.
+type Decoder struct {
+	r       io.Reader
+	roffset int64
+	buf     []byte
+
+	readHeader bool
+	err        error
+}
+
+// NewDecoder returns a Decoder that reads Snappy data from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.Reset(r)
+	return d
+}
+
+// Reset discards the Decoder's state and prepares it to read a new stream
+// from r.
+func (d *Decoder) Reset(r io.Reader) {
+	d.r = r
+	d.roffset = 0
+	d.readHeader = false
+	d.err = nil
+}
+
+// NextBlock decodes the next chunk that contains data. It appends the
+// decompressed data to dst and the chunk's matches to matches, and returns
+// the updated slices. Each chunk is independent, so the matches never refer
+// to data from previous chunks. At the end of the stream, NextBlock returns
+// io.EOF.
+func (d *Decoder) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if d.err != nil {
+		return dst, matches, d.err
+	}
+
+	for {
+		var header [4]byte
+		n, err := io.ReadFull(d.r, header[:])
+		d.roffset += int64(n)
+		if err != nil {
+			d.err = err
+			return dst, matches, err
+		}
+		chunkType := header[0]
+		chunkLen := int(header[1]) | int(header[2])<<8 | int(header[3])<<16
+
+		if !d.readHeader && chunkType != chunkStreamID {
+			d.err = ErrHeader
+			return dst, matches, d.err
+		}
+
+		if cap(d.buf) < chunkLen {
+			d.buf = make([]byte, chunkLen)
+		}
+		chunk := d.buf[:chunkLen]
+		chunkStart := d.roffset
+		n, err = io.ReadFull(d.r, chunk)
+		d.roffset += int64(n)
+		if err != nil {
+			d.err = noEOF(err)
+			return dst, matches, d.err
+		}
+
+		switch {
+		case chunkType == chunkStreamID:
+			if string(chunk) != string(magicChunk[4:]) {
+				d.err = ErrHeader
+				return dst, matches, d.err
+			}
+			d.readHeader = true
+
+		case chunkType == chunkCompressed || chunkType == chunkUncompressed:
+			if chunkLen < 4 {
+				d.err = CorruptInputError(d.roffset)
+				return dst, matches, d.err
+			}
+			checksum := binary.LittleEndian.Uint32(chunk)
+			start, startMatches := len(dst), len(matches)
+			if chunkType == chunkCompressed {
+				dst, matches, err = decodeBlock(dst, chunk[4:], matches)
+				if err != nil {
+					d.err = CorruptInputError(chunkStart + 4 + int64(err.(CorruptInputError)))
+					return dst[:start], matches[:startMatches], d.err
+				}
+			} else {
+				if chunkLen-4 > maxBlockSize {
+					d.err = CorruptInputError(d.roffset)
+					return dst, matches, d.err
+				}
+				dst = append(dst, chunk[4:]...)
+				if chunkLen > 4 {
+					matches = append(matches, pack.Match{Unmatched: chunkLen - 4})
+				}
+			}
+			if crc(dst[start:]) != checksum {
+				d.err = ErrChecksum
+				return dst[:start], matches[:startMatches], d.err
+			}
+			return dst, matches, nil
+
+		case chunkType == chunkPadding || chunkType >= 0x80:
+			// Padding and reserved skippable chunks are ignored.
+
+		default:
+			d.err = ErrUnsupported
+			return dst, matches, d.err
+		}
+	}
+}
+
+// noEOF returns err, unless err == io.EOF, in which case it returns io.ErrUnexpectedEOF.
+func noEOF(e error) error {
+	if e == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return e
+}
+
+// decodeBlock decompresses the block in src (in the Snappy block format),
+// appending the data to dst and the copies to matches. Adjacent copies with
+// the same offset are reported as a single match, since long copies have to
+// be split up in the block format.
+func decodeBlock(dst, src []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	length, n := binary.Uvarint(src)
+	if n <= 0 || length > maxBlockSize {
+		return dst, matches, CorruptInputError(0)
+	}
+	start := len(dst)
+	end := start + int(length)
+	firstMatch := len(matches)
+	s := n
+	unmatched := 0
+
+	for s < len(src) {
+		tag := src[s]
+		var offset, copyLen int
+		switch tag & 3 {
+		case tagLiteral:
+			litLen := int(tag >> 2)
+			s++
+			if litLen >= 60 {
+				nb := litLen - 59
+				if s+nb > len(src) {
+					return dst, matches, CorruptInputError(len(src))
+				}
+				litLen = 0
+				for i := 0; i < nb; i++ {
+					litLen |= int(src[s+i]) << uint(8*i)
+				}
+				s += nb
+			}
+			litLen++
+			if litLen > len(src)-s || litLen > end-len(dst) {
+				return dst, matches, CorruptInputError(s)
+			}
+			dst = append(dst, src[s:s+litLen]...)
+			s += litLen
+			unmatched += litLen
+			continue
+
+		case tagCopy1:
+			if s+2 > len(src) {
+				return dst, matches, CorruptInputError(len(src))
+			}
+			copyLen = 4 + int(tag>>2)&7
+			offset = int(tag&0xe0)<<3 | int(src[s+1])
+			s += 2
+
+		case tagCopy2:
+			if s+3 > len(src) {
+				return dst, matches, CorruptInputError(len(src))
+			}
+			copyLen = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[s+1:]))
+			s += 3
+
+		case tagCopy4:
+			if s+5 > len(src) {
+				return dst, matches, CorruptInputError(len(src))
+			}
+			copyLen = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[s+1:]))
+			s += 5
+		}
+
+		if offset <= 0 || offset > len(dst)-start || copyLen > end-len(dst) {
+			return dst, matches, CorruptInputError(s)
+		}
+		pos := len(dst) - offset
+		for remaining := copyLen; remaining > 0; {
+			// When the copy overlaps the data it is producing, copy it in
+			// chunks, each of which is a whole number of repetitions.
+			chunk := dst[pos:]
+			if len(chunk) > remaining {
+				chunk = chunk[:remaining]
+			}
+			dst = append(dst, chunk...)
+			remaining -= len(chunk)
+		}
+
+		if last := len(matches) - 1; unmatched == 0 && last >= firstMatch && matches[last].Distance == offset {
+			matches[last].Length += copyLen
+		} else {
+			matches = append(matches, pack.Match{
+				Unmatched: unmatched,
+				Length:    copyLen,
+				Distance:  offset,
+			})
+		}
+		unmatched = 0
+	}
+
+	if len(dst) != end {
+		return dst, matches, CorruptInputError(len(src))
+	}
+	if unmatched > 0 {
+		matches = append(matches, pack.Match{Unmatched: unmatched})
+	}
+	return dst, matches, nil
+}
+
+// A Reader decompresses a stream in the Snappy framing format.
+type Reader struct {
+	d       *Decoder
+	buf     []byte
+	pos     int
+	matches []pack.Match
+	err     error
+}
+
+// NewReader returns a Reader that decompresses Snappy data from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: NewDecoder(r)}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.matches, r.err = r.d.NextBlock(r.buf[:0], r.matches[:0])
+		r.pos = 0
+	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// Reset discards the Reader's state and prepares it to read a new stream
+// from src.
+func (r *Reader) Reset(src io.Reader) {
+	r.d.Reset(src)
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.matches = r.matches[:0]
+	r.err = nil
+}
@@ -26,6 +26,16 @@ func (e *Encoder) Reset() {
 	e.wroteHeader = false
 }
 
+// Limits returns the block size and minimum match length for the Snappy
+// framing format. Each chunk is compressed independently.
+func (e *Encoder) Limits() pack.Limits {
+	return pack.Limits{
+		BlockSize:         65536,
+		MinLength:         4,
+		IndependentBlocks: true,
+	}
+}
+
 func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	if len(src) > 65536 {
 		panic("block too large")
@@ -80,6 +90,16 @@ func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock
 	return dst
 }
 
+// Flush makes sure that the stream identifier has been written. Each call to
+// Encode produces a complete chunk, so no other sync marker is needed.
+func (e *Encoder) Flush(dst []byte) []byte {
+	if !e.wroteHeader {
+		dst = append(dst, magicChunk...)
+		e.wroteHeader = true
+	}
+	return dst
+}
+
 const (
 	tagLiteral = 0x00
 	tagCopy1   = 0x01
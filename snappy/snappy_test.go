@@ -2,7 +2,9 @@ package snappy
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"testing"
 
 	"github.com/andybalholm/pack"
@@ -40,7 +42,38 @@ func TestEncode(t *testing.T) {
 }
 
 func TestEncodeDualHash(t *testing.T) {
-	test(t, "../testdata/Isaac.Newton-Opticks.txt", pack.AutoReset{&flate.DualHash{}})
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", pack.AutoReset{MatchFinder: &flate.DualHash{}})
+}
+
+func TestFlush(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := NewWriter(b)
+	w.Write(data[:100000])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	partial := make([]byte, 100000)
+	if _, err := io.ReadFull(snappy.NewReader(bytes.NewReader(b.Bytes())), partial); err != nil {
+		t.Fatalf("error decompressing flushed data: %v", err)
+	}
+	if !bytes.Equal(partial, data[:100000]) {
+		t.Fatal("flushed output doesn't match")
+	}
+
+	w.Write(data[100000:])
+	w.Close()
+	decompressed, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
 }
 
 func benchmark(b *testing.B, filename string, m pack.MatchFinder) {
@@ -75,7 +108,7 @@ func BenchmarkEncode(b *testing.B) {
 }
 
 func BenchmarkEncodeDualHash(b *testing.B) {
-	benchmark(b, "../testdata/Isaac.Newton-Opticks.txt", pack.AutoReset{&flate.DualHash{}})
+	benchmark(b, "../testdata/Isaac.Newton-Opticks.txt", pack.AutoReset{MatchFinder: &flate.DualHash{}})
 }
 
 func BenchmarkEncodeGolangSnappy(b *testing.B) {
@@ -99,3 +132,116 @@ func BenchmarkEncodeGolangSnappy(b *testing.B) {
 		w.Close()
 	}
 }
+
+func TestDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(bytes.Buffer)
+	w := snappy.NewBufferedWriter(b)
+	w.Write(data)
+	w.Close()
+
+	d := NewDecoder(bytes.NewReader(b.Bytes()))
+	var decompressed []byte
+	var matches []pack.Match
+	var reencoded []byte
+	e := new(Encoder)
+	for {
+		start := len(decompressed)
+		decompressed, matches, err = d.NextBlock(decompressed, matches[:0])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Re-encode the chunk, using the matches from the decoder.
+		reencoded = e.Encode(reencoded, decompressed[start:], matches, false)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	decompressed, err = ioutil.ReadAll(snappy.NewReader(bytes.NewReader(reencoded)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("re-encoded output doesn't match")
+	}
+}
+
+func TestReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Some incompressible data, to get uncompressed chunks.
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	b := new(bytes.Buffer)
+	w := NewWriter(b)
+	w.Write(data[:100000])
+	w.Flush()
+	// padding, a reserved skippable chunk, and a repeated stream identifier
+	b.Write([]byte{0xfe, 3, 0, 0, 0, 0, 0})
+	b.Write([]byte{0x80, 2, 0, 0, 'h', 'i'})
+	b.Write(magicChunk)
+	w.Write(random)
+	w.Write(data[100000:])
+	w.Close()
+	want := append(append(append([]byte(nil), data[:100000]...), random...), data[100000:]...)
+
+	compressed := b.Bytes()
+	decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, want) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	corrupted := append([]byte(nil), compressed...)
+	corrupted[len(magicChunk)+5]++
+	if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(corrupted))); err != ErrChecksum {
+		t.Fatalf("got %v with bad checksum, want %v", err, ErrChecksum)
+	}
+	// The rejected chunk's data and matches should both be dropped.
+	dst, matches, err := NewDecoder(bytes.NewReader(corrupted)).NextBlock(nil, nil)
+	if err != ErrChecksum || len(dst) != 0 || len(matches) != 0 {
+		t.Fatalf("got %d bytes, %d matches, and %v with bad checksum; want none and %v", len(dst), len(matches), err, ErrChecksum)
+	}
+
+	if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed[len(magicChunk):]))); err != ErrHeader {
+		t.Fatalf("got %v with missing stream identifier, want %v", err, ErrHeader)
+	}
+
+	unskippable := append(append([]byte(nil), magicChunk...), 0x02, 0, 0, 0)
+	if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(unskippable))); err != ErrUnsupported {
+		t.Fatalf("got %v with reserved unskippable chunk, want %v", err, ErrUnsupported)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	w := snappy.NewBufferedWriter(buf)
+	w.Write(opticks)
+	w.Close()
+	compressed := buf.Bytes()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(opticks)))
+	r := NewReader(bytes.NewReader(compressed))
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
@@ -51,3 +51,12 @@ func (a AutoReset) FindMatches(dst []Match, src []byte) []Match {
 	a.Reset()
 	return a.MatchFinder.FindMatches(dst, src)
 }
+
+// SetDictionary passes dict on to the wrapped MatchFinder, if it implements
+// DictionaryMatchFinder. Since the dictionary is reloaded at each Reset,
+// every block can refer to it.
+func (a AutoReset) SetDictionary(dict []byte) {
+	if d, ok := a.MatchFinder.(DictionaryMatchFinder); ok {
+		d.SetDictionary(dict)
+	}
+}
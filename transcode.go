@@ -0,0 +1,238 @@
+package pack
+
+import "io"
+
+// A MatchSource is a decoder that returns the matches that compressed data
+// was encoded with, along with the decompressed data. The Decoder types in
+// the subpackages implement it.
+type MatchSource interface {
+	// NextBlock appends the next block of decompressed data to dst and the
+	// block's matches to matches, and returns the updated slices. The matches
+	// may refer back to data from previous blocks. At the end of the stream,
+	// NextBlock returns io.EOF.
+	NextBlock(dst []byte, matches []Match) ([]byte, []Match, error)
+}
+
+// Limits describes the matches and blocks that an Encoder can handle.
+// A zero value for any of the fields means that there is no limit.
+type Limits struct {
+	// BlockSize is the largest block that can be passed to Encode.
+	BlockSize int
+
+	// MinLength and MaxLength are the shortest and longest matches that
+	// can be encoded.
+	MinLength int
+	MaxLength int
+
+	// MaxDistance is the farthest back that a match can refer to.
+	MaxDistance int
+
+	// IndependentBlocks is true if matches may not refer to data from
+	// previous blocks.
+	IndependentBlocks bool
+}
+
+// A Limiter is an Encoder that can't handle every possible match or block
+// size. Transcode uses its Limits to adjust the matches it passes to Encode.
+type Limiter interface {
+	Limits() Limits
+}
+
+// Transcode converts compressed data from one format to another. It reads
+// the decompressed data and matches from src, and encodes them with e,
+// writing the result to w. Since it reuses the matches that src was
+// encoded with, it doesn't need a MatchFinder.
+//
+// If e implements Limiter, the blocks and matches are adjusted to fit its
+// Limits: large blocks are split, long matches are broken up, and matches
+// that can't be encoded are changed to unmatched bytes.
+func Transcode(w io.Writer, e Encoder, src MatchSource) error {
+	t := &transcoder{
+		w: w,
+		e: e,
+	}
+	if l, ok := e.(Limiter); ok {
+		t.limits = l.Limits()
+	}
+	if t.limits.MinLength < 1 {
+		t.limits.MinLength = 1
+	}
+	e.Reset()
+
+	// Each block is encoded after the next one is read, so that we know
+	// which one is the last block.
+	var block, next []byte
+	var matches, nextMatches []Match
+	for {
+		var err error
+		next, nextMatches, err = src.NextBlock(next[:0], nextMatches[:0])
+		if err != nil && err != io.EOF {
+			return err
+		}
+		lastBlock := err == io.EOF
+		if len(block) > 0 || lastBlock {
+			if err := t.encodeBlock(block, matches, lastBlock); err != nil {
+				return err
+			}
+		}
+		if lastBlock {
+			return nil
+		}
+		block, next = next, block
+		matches, nextMatches = nextMatches, matches
+	}
+}
+
+type transcoder struct {
+	w      io.Writer
+	e      Encoder
+	limits Limits
+
+	out []byte
+
+	block      []byte
+	pos        int // position in block
+	pieceStart int // start of the piece of block that is being converted
+	pieceEnd   int
+	unmatched  int
+	matches    []Match
+}
+
+// encodeBlock splits block into pieces that fit the limits, and encodes them.
+func (t *transcoder) encodeBlock(block []byte, matches []Match, lastBlock bool) error {
+	t.block = block
+	t.pos = 0
+	t.pieceStart = 0
+	t.pieceEnd = len(block)
+	if t.limits.BlockSize > 0 && t.pieceEnd > t.limits.BlockSize {
+		t.pieceEnd = t.limits.BlockSize
+	}
+	t.unmatched = 0
+	t.matches = t.matches[:0]
+
+	for _, m := range matches {
+		if err := t.addUnmatched(m.Unmatched); err != nil {
+			return err
+		}
+		if err := t.addMatch(m.Length, m.Distance); err != nil {
+			return err
+		}
+	}
+	if t.pos < len(block) {
+		if err := t.addUnmatched(len(block) - t.pos); err != nil {
+			return err
+		}
+	}
+
+	return t.encodePiece(lastBlock)
+}
+
+func (t *transcoder) addUnmatched(n int) error {
+	for n > 0 {
+		k := n
+		if k > t.pieceEnd-t.pos {
+			k = t.pieceEnd - t.pos
+		}
+		t.unmatched += k
+		t.pos += k
+		n -= k
+		if err := t.checkPieceEnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transcoder) addMatch(length, distance int) error {
+	for length > 0 {
+		k := length
+		if k > t.pieceEnd-t.pos {
+			k = t.pieceEnd - t.pos
+		}
+		t.appendMatch(k, distance)
+		t.pos += k
+		length -= k
+		if err := t.checkPieceEnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendMatch adds a match that fits within the current piece, adjusting it
+// to fit the limits.
+func (t *transcoder) appendMatch(length, distance int) {
+	if t.limits.MaxDistance > 0 && distance > t.limits.MaxDistance {
+		t.unmatched += length
+		return
+	}
+	if t.limits.IndependentBlocks {
+		// The bytes before pieceStart+distance would need to be copied from
+		// the previous piece.
+		if n := t.pieceStart + distance - t.pos; n > 0 {
+			if n > length {
+				n = length
+			}
+			t.unmatched += n
+			length -= n
+		}
+	}
+	if length < t.limits.MinLength {
+		t.unmatched += length
+		return
+	}
+
+	for t.limits.MaxLength > 0 && length > t.limits.MaxLength {
+		// The match is too long; break it up into shorter matches.
+		k := t.limits.MaxLength
+		if length-k < t.limits.MinLength {
+			k = length - t.limits.MinLength
+		}
+		t.matches = append(t.matches, Match{
+			Unmatched: t.unmatched,
+			Length:    k,
+			Distance:  distance,
+		})
+		t.unmatched = 0
+		length -= k
+	}
+	t.matches = append(t.matches, Match{
+		Unmatched: t.unmatched,
+		Length:    length,
+		Distance:  distance,
+	})
+	t.unmatched = 0
+}
+
+// checkPieceEnd encodes the current piece if it is complete and there is
+// more of the block to come.
+func (t *transcoder) checkPieceEnd() error {
+	if t.pos < t.pieceEnd || t.pieceEnd == len(t.block) {
+		return nil
+	}
+	if err := t.encodePiece(false); err != nil {
+		return err
+	}
+	t.pieceStart = t.pos
+	t.pieceEnd = len(t.block)
+	if t.limits.BlockSize > 0 && t.pieceEnd-t.pieceStart > t.limits.BlockSize {
+		t.pieceEnd = t.pieceStart + t.limits.BlockSize
+	}
+	return nil
+}
+
+func (t *transcoder) encodePiece(lastBlock bool) error {
+	if t.unmatched > 0 {
+		t.matches = append(t.matches, Match{Unmatched: t.unmatched})
+		t.unmatched = 0
+	}
+	t.out = t.e.Encode(t.out[:0], t.block[t.pieceStart:t.pos], t.matches, lastBlock)
+	t.matches = t.matches[:0]
+	if r, ok := t.e.(ErrorReporter); ok {
+		if err := r.Err(); err != nil {
+			return err
+		}
+	}
+	_, err := t.w.Write(t.out)
+	return err
+}
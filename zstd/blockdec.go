@@ -28,6 +28,11 @@ const (
 	// maxCompressedBlockSize is the biggest allowed compressed block size (128KB)
 	maxCompressedBlockSize = 128 << 10
 
+	// https://github.com/facebook/zstd/blob/dev/doc/zstd_compression_format.md#literals_section_header
+	maxCompressedLiteralSize = 1 << 18
+	maxMatchLen              = 131074
+	maxSequences             = 0x7f00 + 0xffff
+
 	// We support slightly less than the reference decoder to be able to
 	// use ints on 32 bit archs.
 	maxOffsetBits = 30
@@ -22,6 +22,13 @@ type blockEnc struct {
 	dictLitEnc *huff0.Scratch
 	wr         bitWriter
 
+	// litNew is scratch space for building a new literals table, to
+	// compare with repeating the one in litEnc.
+	litNew *huff0.Scratch
+
+	// tableBuf is scratch space for measuring FSE table descriptions.
+	tableBuf []byte
+
 	extraLits         int
 	output            []byte
 	recentOffsets     [3]uint32
@@ -69,6 +76,7 @@ func (b *blockEnc) init() {
 		b.coders.llPrev = &fseEncoder{}
 	}
 	b.litEnc = &huff0.Scratch{WantLogLess: 4}
+	b.litNew = &huff0.Scratch{WantLogLess: 4}
 	b.reset(nil)
 }
 
@@ -296,9 +304,51 @@ func (b *blockEnc) matchOffset(offset, lits uint32) uint32 {
 	return offset
 }
 
+// compressLiterals Huffman-compresses lits, either with the table from the
+// previous block (treeless literals) or with a new table, whichever gives
+// the smaller output. Afterwards, b.litEnc holds the table that the decoder
+// will have (if the block is written in compressed form).
+func (b *blockEnc) compressLiterals(lits []byte, single bool) (out []byte, reUsed bool, err error) {
+	if b.dictLitEnc != nil {
+		b.litEnc.TransferCTable(b.dictLitEnc)
+		b.litEnc.Reuse = huff0.ReusePolicyAllow
+		b.dictLitEnc = nil
+	}
+	compress := huff0.Compress4X
+	if single {
+		compress = huff0.Compress1X
+	}
+
+	// b.litEnc and b.litNew have separate output buffers, so both results
+	// are available for comparison. Repeating the table fails quickly if
+	// it can't encode all the symbols in lits.
+	var repeated []byte
+	if b.litEnc.Reuse != huff0.ReusePolicyNone {
+		b.litEnc.Reuse = huff0.ReusePolicyMust
+		repeated, _, err = compress(lits, b.litEnc)
+		b.litEnc.Reuse = huff0.ReusePolicyAllow
+		if err != nil {
+			repeated = nil
+		}
+	}
+
+	b.litNew.Reuse = huff0.ReusePolicyNone
+	out, _, err = compress(lits, b.litNew)
+	switch {
+	case err == huff0.ErrUseRLE:
+		return nil, false, err
+	case repeated != nil && (err != nil || len(repeated) <= len(out)):
+		return repeated, true, nil
+	case err != nil:
+		return nil, false, err
+	}
+	b.litEnc, b.litNew = b.litNew, b.litEnc
+	b.litEnc.Reuse = huff0.ReusePolicyAllow
+	return out, false, nil
+}
+
 // encodeLits can be used if the block is only litLen.
 func (b *blockEnc) encodeLits(lits []byte, raw bool) error {
-	panic("encodeLits")
 	var bh blockHeader
 	bh.setLast(b.last)
 	bh.setSize(uint32(len(lits)))
@@ -319,18 +369,13 @@ func (b *blockEnc) encodeLits(lits []byte, raw bool) error {
 		reUsed, single bool
 		err            error
 	)
-	if b.dictLitEnc != nil {
-		b.litEnc.TransferCTable(b.dictLitEnc)
-		b.litEnc.Reuse = huff0.ReusePolicyAllow
-		b.dictLitEnc = nil
-	}
 	if len(lits) >= 1024 {
 		// Use 4 Streams.
-		out, reUsed, err = huff0.Compress4X(lits, b.litEnc)
+		out, reUsed, err = b.compressLiterals(lits, false)
 	} else if len(lits) > 32 {
 		// Use 1 stream
 		single = true
-		out, reUsed, err = huff0.Compress1X(lits, b.litEnc)
+		out, reUsed, err = b.compressLiterals(lits, true)
 	} else {
 		err = huff0.ErrIncompressible
 	}
@@ -357,8 +402,6 @@ func (b *blockEnc) encodeLits(lits []byte, raw bool) error {
 		return err
 	}
 	// Compressed...
-	// Now, allow reuse
-	b.litEnc.Reuse = huff0.ReusePolicyAllow
 	bh.setType(blockTypeCompressed)
 	var lh literalsHeader
 	if reUsed {
@@ -415,18 +458,13 @@ func (b *blockEnc) encode(org []byte, raw, rawAllLits bool) error {
 		reUsed, single bool
 		err            error
 	)
-	if b.dictLitEnc != nil {
-		b.litEnc.TransferCTable(b.dictLitEnc)
-		b.litEnc.Reuse = huff0.ReusePolicyAllow
-		b.dictLitEnc = nil
-	}
 	if len(b.literals) >= 1024 && !raw {
 		// Use 4 Streams.
-		out, reUsed, err = huff0.Compress4X(b.literals, b.litEnc)
+		out, reUsed, err = b.compressLiterals(b.literals, false)
 	} else if len(b.literals) > 32 && !raw {
 		// Use 1 stream
 		single = true
-		out, reUsed, err = huff0.Compress1X(b.literals, b.litEnc)
+		out, reUsed, err = b.compressLiterals(b.literals, true)
 	} else {
 		err = huff0.ErrIncompressible
 	}
@@ -474,7 +512,6 @@ func (b *blockEnc) encode(org []byte, raw, rawAllLits bool) error {
 		}
 		b.output = lh.appendTo(b.output)
 		b.output = append(b.output, out...)
-		b.litEnc.Reuse = huff0.ReusePolicyAllow
 		if debugEncoder {
 			println("Adding literals compressed")
 		}
@@ -522,13 +559,18 @@ func (b *blockEnc) encode(org []byte, raw, rawAllLits bool) error {
 	chooseComp := func(cur, prev, preDef *fseEncoder) (*fseEncoder, seqCompMode) {
 		// See if predefined/previous is better
 		hist := cur.count[:cur.symbolLen]
-		nSize := cur.approxSize(hist) + cur.maxHeaderSize()
+		// Include the actual size of the new table's description, since
+		// that is what repeating the previous table saves.
+		nSize := cur.approxSize(hist)
+		header, err := cur.writeCount(b.tableBuf[:0])
+		b.tableBuf = header
+		if err != nil || nSize == math.MaxUint32 {
+			nSize = math.MaxUint32
+		} else {
+			nSize += uint32(len(header)) * 8
+		}
 		predefSize := preDef.approxSize(hist)
 		prevSize := prev.approxSize(hist)
-
-		// Add a small penalty for new encoders.
-		// Don't bother with extremely small (<2 byte gains).
-		nSize = nSize + (nSize+2*8*16)>>4
 		switch {
 		case predefSize <= prevSize && predefSize <= nSize || forcePreDef:
 			if debugEncoder {
@@ -0,0 +1,142 @@
+package zstd
+
+import (
+	"math"
+
+	"github.com/andybalholm/pack"
+)
+
+// costs holds the estimated number of bits for each literal byte and for each
+// literal length, match length, and offset code.
+type costs struct {
+	literal     [256]float32
+	literalLen  [maxLLCode + 1]float32
+	matchLen    [maxMLCode + 1]float32
+	offset      [32]float32
+	initialized bool
+}
+
+// init sets the costs to their starting values: 8 bits per literal, and the
+// predefined FSE distributions for the sequence codes.
+func (c *costs) init() {
+	initPredefined()
+	for i := range c.literal {
+		c.literal[i] = 8
+	}
+	normCosts(c.literalLen[:], &fsePredefEnc[tableLiteralLengths])
+	normCosts(c.matchLen[:], &fsePredefEnc[tableMatchLengths])
+	normCosts(c.offset[:], &fsePredefEnc[tableOffsets])
+	c.initialized = true
+}
+
+// normCosts sets costs from the normalized counts in an FSE table.
+func normCosts(costs []float32, f *fseEncoder) {
+	tableLog := float32(f.actualTableLog)
+	for i := range costs {
+		switch {
+		case i >= int(f.symbolLen):
+			costs[i] = tableLog + 1
+		case f.norm[i] <= 0:
+			// A count of -1 means a probability of 1/2^tableLog.
+			costs[i] = tableLog
+		default:
+			costs[i] = tableLog - float32(math.Log2(float64(f.norm[i])))
+		}
+	}
+}
+
+// update sets the costs from the statistics of a block's literals and
+// sequences. If the block doesn't have any literals or sequences, the
+// corresponding costs are left as they were.
+func (c *costs) update(literals []byte, sequences []seq) {
+	if len(literals) > 0 {
+		var hist [256]int
+		for _, b := range literals {
+			hist[b]++
+		}
+		histCosts(c.literal[:], hist[:], len(literals), huff0MaxTableLog)
+	}
+
+	if len(sequences) > 0 {
+		var ll [maxLLCode + 1]int
+		var ml [maxMLCode + 1]int
+		var of [32]int
+		for _, s := range sequences {
+			ll[llCode(s.litLen)]++
+			ml[mlCode(s.matchLen)]++
+			of[ofCode(s.offset)]++
+		}
+		histCosts(c.literalLen[:], ll[:], len(sequences), maxFSETableLog)
+		histCosts(c.matchLen[:], ml[:], len(sequences), maxFSETableLog)
+		histCosts(c.offset[:], of[:], len(sequences), maxFSETableLog)
+	}
+}
+
+const (
+	huff0MaxTableLog = 11
+	maxFSETableLog   = 9
+)
+
+// histCosts sets costs to the entropy of each symbol in hist, but not more
+// than maxBits. Symbols that don't occur are given a cost as if they had
+// occurred half as often as the rarest possible symbol.
+func histCosts(costs []float32, hist []int, total int, maxBits float32) {
+	totalBits := float32(math.Log2(float64(total)))
+	for i, n := range hist {
+		c := totalBits + 1
+		if n > 0 {
+			c = totalBits - float32(math.Log2(float64(n)))
+		}
+		if c > maxBits {
+			c = maxBits
+		}
+		costs[i] = c
+	}
+}
+
+// LiteralCost returns the estimated cost of b, based on how often it occurred
+// in the most recent block's literals.
+func (e *Encoder) LiteralCost(b byte) float32 {
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+	return e.costs.literal[b]
+}
+
+// MatchCost returns the estimated cost of the sequence that encodes m, based
+// on the statistics of the most recent block, including zstd's repeat-offset
+// codes. Matches that are farther back than the window size can't be encoded.
+func (e *Encoder) MatchCost(m pack.Match, recent [4]int) float32 {
+	if m.Length < 3 || m.Length > maxMatchLen || m.Distance < 1 || m.Distance > e.Limits().MaxDistance {
+		return float32(math.Inf(1))
+	}
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+
+	// Find the offset value, as in blockEnc.matchOffset.
+	var offset int
+	switch {
+	case m.Unmatched > 0 && m.Distance == recent[0]:
+		offset = 1
+	case m.Unmatched > 0 && m.Distance == recent[1]:
+		offset = 2
+	case m.Unmatched > 0 && m.Distance == recent[2]:
+		offset = 3
+	case m.Unmatched == 0 && m.Distance == recent[1]:
+		offset = 1
+	case m.Unmatched == 0 && m.Distance == recent[2]:
+		offset = 2
+	case m.Unmatched == 0 && m.Distance == recent[0]-1:
+		offset = 3
+	default:
+		offset = m.Distance + 3
+	}
+
+	ll := llCode(uint32(m.Unmatched))
+	ml := mlCode(uint32(m.Length - zstdMinMatch))
+	of := ofCode(uint32(offset))
+	return e.costs.literalLen[ll] + float32(llBitsTable[ll]) +
+		e.costs.matchLen[ml] + float32(mlBitsTable[ml]) +
+		e.costs.offset[of] + float32(of)
+}
@@ -0,0 +1,724 @@
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+
+	"github.com/andybalholm/pack"
+	"github.com/klauspost/compress/huff0"
+	"github.com/pierrec/xxHash/xxHash64"
+)
+
+var (
+	// ErrChecksum is returned when a frame's content checksum or content
+	// size doesn't match the decompressed data.
+	ErrChecksum = errors.New("zstd: invalid checksum")
+
+	// ErrHeader is returned when a frame header is invalid.
+	ErrHeader = errors.New("zstd: invalid frame header")
+
+	// ErrMagicMismatch is returned when a frame doesn't start with the
+	// zstd magic number.
+	ErrMagicMismatch = errors.New("zstd: invalid magic number")
+
+	// ErrBlockTooSmall is returned when a block is too short to hold the
+	// data that its headers say it contains.
+	ErrBlockTooSmall = errors.New("zstd: block too small")
+
+	// ErrReservedBlockType is returned when a block uses the reserved
+	// block type.
+	ErrReservedBlockType = errors.New("zstd: reserved block type")
+
+	// ErrWindowSizeExceeded is returned when a frame needs a window larger
+	// than maxWindowSize.
+	ErrWindowSizeExceeded = errors.New("zstd: window size exceeded")
+)
+
+const (
+	// maxWindowSize is the largest window size that the Decoder accepts.
+	maxWindowSize = 1 << 29
+
+	skippableFrameMagic = 0x184D2A50
+)
+
+// A Decoder reads a zstd stream one block at a time. Along with the
+// decompressed data, it returns the matches that the data was encoded with,
+// in the same form that a pack.MatchFinder produces, so that the data can be
+// analyzed or re-encoded with a different Encoder.
+type Decoder struct {
+	r   io.Reader
+	buf []byte
+
+	// history holds the decompressed data from previous blocks that may be
+	// referenced by matches, followed by the current block.
+	history    []byte
+	windowSize int
+
+	// dict is the dictionary that frames may use, and dictSize is the
+	// size of its content if the current frame uses it.
+	dict     *Dictionary
+	dictSize int
+
+	literals  []byte
+	huff      *huff0.Scratch
+	seqs      sequenceDecs
+	tables    [3]fseDecoder
+	sequences []seq
+
+	checksum    bool
+	hasher      hash.Hash64
+	contentSize int64
+	frameSize   int64
+
+	startFrame bool
+	final      bool
+	err        error
+}
+
+// NewDecoder returns a Decoder that reads zstd data from r.
+// If the stream contains multiple frames, they are decoded as a single
+// stream. Skippable frames are ignored.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.Reset(r)
+	return d
+}
+
+// Reset discards the Decoder's state and prepares it to read a new stream
+// from r.
+func (d *Decoder) Reset(r io.Reader) {
+	initPredefined()
+	d.r = r
+	d.history = d.history[:0]
+	d.startFrame = true
+	d.final = false
+	d.err = nil
+}
+
+// NextBlock decodes the next zstd block. It appends the decompressed data
+// to dst and the block's matches to matches, and returns the updated slices.
+// The matches cover exactly the data appended to dst, but they may refer back
+// to data from previous blocks. After the last block, NextBlock returns io.EOF.
+func (d *Decoder) NextBlock(dst []byte, matches []pack.Match) ([]byte, []pack.Match, error) {
+	if d.err != nil {
+		return dst, matches, d.err
+	}
+
+	if d.final {
+		if d.err = d.finishFrame(); d.err != nil {
+			return dst, matches, d.err
+		}
+	}
+
+	if d.startFrame {
+		if d.err = d.readFrameHeader(); d.err != nil {
+			return dst, matches, d.err
+		}
+		d.startFrame = false
+	}
+
+	if keep := d.windowSize + d.dictSize; len(d.history) > 2*keep {
+		n := copy(d.history, d.history[len(d.history)-keep:])
+		d.history = d.history[:n]
+	}
+	start := len(d.history)
+
+	matches, d.err = d.nextBlock(matches)
+	if d.err != nil {
+		d.err = noEOF(d.err)
+		return dst, matches, d.err
+	}
+
+	block := d.history[start:]
+	if d.checksum {
+		d.hasher.Write(block)
+	}
+	d.frameSize += int64(len(block))
+	if d.contentSize >= 0 && d.frameSize > d.contentSize {
+		d.err = ErrChecksum
+		return dst, matches, d.err
+	}
+
+	return append(dst, block...), matches, nil
+}
+
+// readFrameHeader reads the header of the next frame, skipping any
+// skippable frames. At the end of the input, it returns io.EOF.
+func (d *Decoder) readFrameHeader() error {
+	var buf [8]byte
+	for {
+		if _, err := io.ReadFull(d.r, buf[:4]); err != nil {
+			return err
+		}
+		magic := binary.LittleEndian.Uint32(buf[:4])
+		if magic&0xFFFFFFF0 != skippableFrameMagic {
+			if magic != binary.LittleEndian.Uint32(frameMagic) {
+				return ErrMagicMismatch
+			}
+			break
+		}
+		if err := d.readFull(buf[:4]); err != nil {
+			return err
+		}
+		n := int64(binary.LittleEndian.Uint32(buf[:4]))
+		if _, err := io.CopyN(ioutil.Discard, d.r, n); err != nil {
+			return noEOF(err)
+		}
+	}
+
+	if err := d.readFull(buf[:1]); err != nil {
+		return err
+	}
+	fhd := buf[0]
+	fcsFlag := fhd >> 6
+	singleSegment := fhd&(1<<5) != 0
+	if fhd&(1<<3) != 0 {
+		return ErrHeader
+	}
+	d.checksum = fhd&(1<<2) != 0
+	dictIDSize := [4]int{0, 1, 2, 4}[fhd&3]
+	var dictID uint32
+
+	d.windowSize = 0
+	if !singleSegment {
+		if err := d.readFull(buf[:1]); err != nil {
+			return err
+		}
+		windowLog := 10 + uint(buf[0]>>3)
+		if windowLog > 30 {
+			return ErrWindowSizeExceeded
+		}
+		windowBase := 1 << windowLog
+		d.windowSize = windowBase + windowBase/8*int(buf[0]&7)
+	}
+
+	if dictIDSize > 0 {
+		if err := d.readFull(buf[:dictIDSize]); err != nil {
+			return err
+		}
+		for i := dictIDSize - 1; i >= 0; i-- {
+			dictID = dictID<<8 | uint32(buf[i])
+		}
+		if dictID != 0 && (d.dict == nil || d.dict.ID != dictID) {
+			return ErrUnknownDictionary
+		}
+	}
+
+	d.contentSize = -1
+	fcsSize := [4]int{0, 2, 4, 8}[fcsFlag]
+	if fcsFlag == 0 && singleSegment {
+		fcsSize = 1
+	}
+	if fcsSize > 0 {
+		if err := d.readFull(buf[:fcsSize]); err != nil {
+			return err
+		}
+		var size uint64
+		for i := fcsSize - 1; i >= 0; i-- {
+			size = size<<8 | uint64(buf[i])
+		}
+		if fcsSize == 2 {
+			size += 256
+		}
+		if size > 1<<62 {
+			return ErrHeader
+		}
+		d.contentSize = int64(size)
+	}
+
+	if singleSegment {
+		d.windowSize = int(d.contentSize)
+	}
+	if d.windowSize > maxWindowSize {
+		return ErrWindowSizeExceeded
+	}
+
+	if d.checksum {
+		if d.hasher == nil {
+			d.hasher = xxHash64.New(0)
+		} else {
+			d.hasher.Reset()
+		}
+	}
+	d.frameSize = 0
+	d.history = d.history[:0]
+	d.seqs.prevOffset = [3]int{1, 4, 8}
+	d.seqs.litLengths.fse = nil
+	d.seqs.offsets.fse = nil
+	d.seqs.matchLengths.fse = nil
+	d.huff = nil
+	d.dictSize = 0
+	if d.dict != nil && d.dict.ID == dictID {
+		return d.startDictionary()
+	}
+	return nil
+}
+
+// startDictionary sets up the state at the start of a frame that uses d.dict.
+func (d *Decoder) startDictionary() error {
+	dict := d.dict
+	d.history = append(d.history, dict.Content...)
+	d.dictSize = len(dict.Content)
+	d.seqs.prevOffset = dict.Offsets
+	if dict.hasTables {
+		// Read the Huffman table again, since the Decoder's copy will be
+		// overwritten when a block has a new table.
+		huff, _, err := huff0.ReadTable(dict.literalTable, nil)
+		if err != nil {
+			return err
+		}
+		d.huff = huff
+		d.seqs.litLengths.fse = &dict.tables[tableLiteralLengths]
+		d.seqs.offsets.fse = &dict.tables[tableOffsets]
+		d.seqs.matchLengths.fse = &dict.tables[tableMatchLengths]
+	}
+	return nil
+}
+
+// SetDictionary sets the dictionary that frames may be compressed with.
+// It may be either a formatted dictionary, which is used for frames with
+// its ID in the header, or raw content, which is used for all frames with no
+// dictionary ID. If dict is nil, d stops using a dictionary.
+func (d *Decoder) SetDictionary(dict []byte) error {
+	if dict == nil {
+		d.dict = nil
+		return nil
+	}
+	parsed, err := ParseDictionary(dict)
+	if err != nil {
+		return err
+	}
+	d.dict = parsed
+	return nil
+}
+
+// finishFrame is called after the last block of a frame. It checks the
+// content size and checksum, and prepares to read another frame.
+func (d *Decoder) finishFrame() error {
+	if d.contentSize >= 0 && d.frameSize != d.contentSize {
+		return ErrChecksum
+	}
+	if d.checksum {
+		var buf [4]byte
+		if err := d.readFull(buf[:]); err != nil {
+			return err
+		}
+		if binary.LittleEndian.Uint32(buf[:]) != uint32(d.hasher.Sum64()) {
+			return ErrChecksum
+		}
+	}
+	d.final = false
+	d.startFrame = true
+	return nil
+}
+
+// readFull is like io.ReadFull, but it treats io.EOF as an unexpected EOF.
+func (d *Decoder) readFull(buf []byte) error {
+	_, err := io.ReadFull(d.r, buf)
+	return noEOF(err)
+}
+
+// noEOF returns err, unless err == io.EOF, in which case it returns io.ErrUnexpectedEOF.
+func noEOF(e error) error {
+	if e == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return e
+}
+
+func (d *Decoder) nextBlock(matches []pack.Match) ([]pack.Match, error) {
+	var header [3]byte
+	if err := d.readFull(header[:]); err != nil {
+		return matches, err
+	}
+	bh := uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16
+	d.final = bh&1 != 0
+	typ := blockType((bh >> 1) & 3)
+	size := int(bh >> 3)
+
+	maxSize := maxCompressedBlockSize
+	if d.windowSize < maxSize {
+		maxSize = d.windowSize
+	}
+	if size > maxSize {
+		return matches, fmt.Errorf("zstd: block size (%d) exceeds maximum (%d)", size, maxSize)
+	}
+
+	switch typ {
+	case blockTypeRaw:
+		start := len(d.history)
+		d.history = append(d.history, make([]byte, size)...)
+		if err := d.readFull(d.history[start:]); err != nil {
+			d.history = d.history[:start]
+			return matches, err
+		}
+		if size == 0 {
+			return matches, nil
+		}
+		return append(matches, pack.Match{Unmatched: size}), nil
+
+	case blockTypeRLE:
+		var b [1]byte
+		if err := d.readFull(b[:]); err != nil {
+			return matches, err
+		}
+		for i := 0; i < size; i++ {
+			d.history = append(d.history, b[0])
+		}
+		if size == 0 {
+			return matches, nil
+		}
+		return append(matches, pack.Match{Unmatched: size}), nil
+
+	case blockTypeCompressed:
+		if cap(d.buf) < size {
+			d.buf = make([]byte, size, maxCompressedBlockSize)
+		}
+		d.buf = d.buf[:size]
+		if err := d.readFull(d.buf); err != nil {
+			return matches, err
+		}
+		return d.compressedBlock(matches, d.buf)
+
+	default:
+		return matches, ErrReservedBlockType
+	}
+}
+
+// compressedBlock decodes the contents of a compressed block, appending the
+// data to d.history and the matches to matches.
+func (d *Decoder) compressedBlock(matches []pack.Match, in []byte) ([]pack.Match, error) {
+	in, err := d.readLiterals(in)
+	if err != nil {
+		return matches, err
+	}
+
+	d.sequences, err = d.readSequences(in, d.sequences[:0])
+	if err != nil {
+		return matches, err
+	}
+
+	literals := d.literals
+	start := len(d.history)
+	for _, s := range d.sequences {
+		ll := int(s.litLen)
+		ml := int(s.matchLen) + zstdMinMatch
+		if ll > len(literals) {
+			return matches, fmt.Errorf("zstd: unexpected literal count, want %d bytes, but only %d is available", ll, len(literals))
+		}
+		d.history = append(d.history, literals[:ll]...)
+		literals = literals[ll:]
+
+		mo, err := d.seqs.adjustOffset(int(s.offset), ll)
+		if err != nil {
+			return matches, err
+		}
+		if mo > len(d.history) {
+			return matches, fmt.Errorf("zstd: match offset (%d) bigger than current history (%d)", mo, len(d.history))
+		}
+		if len(d.history)+ml-start > maxCompressedBlockSize {
+			return matches, fmt.Errorf("zstd: output bigger than max block size")
+		}
+
+		pos := len(d.history) - mo
+		if ml <= mo {
+			d.history = append(d.history, d.history[pos:pos+ml]...)
+		} else {
+			// Overlapping copy
+			for i := 0; i < ml; i++ {
+				d.history = append(d.history, d.history[pos+i])
+			}
+		}
+
+		matches = append(matches, pack.Match{
+			Unmatched: ll,
+			Length:    ml,
+			Distance:  mo,
+		})
+	}
+
+	if len(literals) > 0 {
+		if len(d.history)+len(literals)-start > maxCompressedBlockSize {
+			return matches, fmt.Errorf("zstd: output bigger than max block size")
+		}
+		d.history = append(d.history, literals...)
+		matches = append(matches, pack.Match{Unmatched: len(literals)})
+	}
+
+	return matches, nil
+}
+
+// readLiterals decodes the literals section at the start of in, storing the
+// literals in d.literals. It returns the rest of the block.
+func (d *Decoder) readLiterals(in []byte) ([]byte, error) {
+	// There must be at least one byte for Literals_Block_Type and one for Sequences_Section_Header
+	if len(in) < 2 {
+		return in, ErrBlockTooSmall
+	}
+	litType := literalsBlockType(in[0] & 3)
+	var litRegenSize int
+	var litCompSize int
+	sizeFormat := (in[0] >> 2) & 3
+	var fourStreams bool
+	switch litType {
+	case literalsBlockRaw, literalsBlockRLE:
+		switch sizeFormat {
+		case 0, 2:
+			// Regenerated_Size uses 5 bits (0-31). Literals_Section_Header uses 1 byte.
+			litRegenSize = int(in[0] >> 3)
+			in = in[1:]
+		case 1:
+			// Regenerated_Size uses 12 bits (0-4095). Literals_Section_Header uses 2 bytes.
+			litRegenSize = int(in[0]>>4) + (int(in[1]) << 4)
+			in = in[2:]
+		case 3:
+			//  Regenerated_Size uses 20 bits (0-1048575). Literals_Section_Header uses 3 bytes.
+			if len(in) < 3 {
+				return in, ErrBlockTooSmall
+			}
+			litRegenSize = int(in[0]>>4) + (int(in[1]) << 4) + (int(in[2]) << 12)
+			in = in[3:]
+		}
+	case literalsBlockCompressed, literalsBlockTreeless:
+		switch sizeFormat {
+		case 0, 1:
+			// Both Regenerated_Size and Compressed_Size use 10 bits (0-1023).
+			if len(in) < 3 {
+				return in, ErrBlockTooSmall
+			}
+			n := uint64(in[0]>>4) + (uint64(in[1]) << 4) + (uint64(in[2]) << 12)
+			litRegenSize = int(n & 1023)
+			litCompSize = int(n >> 10)
+			fourStreams = sizeFormat == 1
+			in = in[3:]
+		case 2:
+			fourStreams = true
+			if len(in) < 4 {
+				return in, ErrBlockTooSmall
+			}
+			n := uint64(in[0]>>4) + (uint64(in[1]) << 4) + (uint64(in[2]) << 12) + (uint64(in[3]) << 20)
+			litRegenSize = int(n & 16383)
+			litCompSize = int(n >> 14)
+			in = in[4:]
+		case 3:
+			fourStreams = true
+			if len(in) < 5 {
+				return in, ErrBlockTooSmall
+			}
+			n := uint64(in[0]>>4) + (uint64(in[1]) << 4) + (uint64(in[2]) << 12) + (uint64(in[3]) << 20) + (uint64(in[4]) << 28)
+			litRegenSize = int(n & 262143)
+			litCompSize = int(n >> 18)
+			in = in[5:]
+		}
+	}
+	if debugDecoder {
+		println("literals type:", litType, "litRegenSize:", litRegenSize, "litCompSize:", litCompSize, "sizeFormat:", sizeFormat, "4X:", fourStreams)
+	}
+	if litRegenSize > maxCompressedBlockSize {
+		return in, fmt.Errorf("zstd: literals size (%d) exceeds maximum block size", litRegenSize)
+	}
+
+	switch litType {
+	case literalsBlockRaw:
+		if len(in) < litRegenSize {
+			return in, ErrBlockTooSmall
+		}
+		d.literals = append(d.literals[:0], in[:litRegenSize]...)
+		return in[litRegenSize:], nil
+
+	case literalsBlockRLE:
+		if len(in) < 1 {
+			return in, ErrBlockTooSmall
+		}
+		d.literals = d.literals[:0]
+		for i := 0; i < litRegenSize; i++ {
+			d.literals = append(d.literals, in[0])
+		}
+		return in[1:], nil
+	}
+
+	if len(in) < litCompSize {
+		return in, ErrBlockTooSmall
+	}
+	literals := in[:litCompSize]
+	in = in[litCompSize:]
+
+	if litType == literalsBlockCompressed {
+		huff := d.huff
+		if huff == nil {
+			huff = &huff0.Scratch{}
+		}
+		var err error
+		huff, literals, err = huff0.ReadTable(literals, huff)
+		if err != nil {
+			return in, fmt.Errorf("zstd: reading huffman table: %v", err)
+		}
+		d.huff = huff
+	} else if d.huff == nil {
+		return in, errors.New("zstd: literal block was treeless, but no history was defined")
+	}
+
+	if cap(d.literals) < litRegenSize {
+		d.literals = make([]byte, 0, maxCompressedLiteralSize)
+	}
+	var err error
+	if fourStreams {
+		d.literals, err = d.huff.Decoder().Decompress4X(d.literals[:0:litRegenSize], literals)
+	} else {
+		d.literals, err = d.huff.Decoder().Decompress1X(d.literals[:0:litRegenSize], literals)
+	}
+	if err != nil {
+		return in, fmt.Errorf("zstd: decoding compressed literals: %v", err)
+	}
+	if len(d.literals) != litRegenSize {
+		return in, fmt.Errorf("zstd: literal output size mismatch want %d, got %d", litRegenSize, len(d.literals))
+	}
+	return in, nil
+}
+
+// readSequences decodes the sequences section of a block, and appends the
+// sequences to dst.
+func (d *Decoder) readSequences(in []byte, dst []seq) ([]seq, error) {
+	// https://github.com/facebook/zstd/blob/dev/doc/zstd_compression_format.md#sequences-section
+	if len(in) < 1 {
+		return dst, ErrBlockTooSmall
+	}
+	seqHeader := in[0]
+	nSeqs := 0
+	switch {
+	case seqHeader == 0:
+		in = in[1:]
+	case seqHeader < 128:
+		nSeqs = int(seqHeader)
+		in = in[1:]
+	case seqHeader < 255:
+		if len(in) < 2 {
+			return dst, ErrBlockTooSmall
+		}
+		nSeqs = int(seqHeader-128)<<8 | int(in[1])
+		in = in[2:]
+	case seqHeader == 255:
+		if len(in) < 3 {
+			return dst, ErrBlockTooSmall
+		}
+		nSeqs = 0x7f00 + int(in[1]) + (int(in[2]) << 8)
+		in = in[3:]
+	}
+	if nSeqs == 0 {
+		if len(in) != 0 {
+			return dst, fmt.Errorf("zstd: %d extra bytes after block with no sequences", len(in))
+		}
+		return dst, nil
+	}
+
+	if len(in) < 1 {
+		return dst, ErrBlockTooSmall
+	}
+	br := byteReader{b: in, off: 0}
+	compMode := br.Uint8()
+	br.advance(1)
+	if compMode&3 != 0 {
+		return dst, errors.New("zstd: reserved bits in sequence compression modes")
+	}
+	for i := uint(0); i < 3; i++ {
+		mode := seqCompMode((compMode >> (6 - i*2)) & 3)
+		if debugDecoder {
+			println("Table", tableIndex(i), "is", mode)
+		}
+		var seq *sequenceDec
+		switch tableIndex(i) {
+		case tableLiteralLengths:
+			seq = &d.seqs.litLengths
+		case tableOffsets:
+			seq = &d.seqs.offsets
+		case tableMatchLengths:
+			seq = &d.seqs.matchLengths
+		}
+		switch mode {
+		case compModePredefined:
+			seq.fse = &fsePredef[i]
+		case compModeRLE:
+			if br.remain() < 1 {
+				return dst, ErrBlockTooSmall
+			}
+			v := br.Uint8()
+			br.advance(1)
+			symb, err := decSymbolValue(v, symbolTableX[i])
+			if err != nil {
+				return dst, fmt.Errorf("zstd: RLE transform table (%v) error: %v", tableIndex(i), err)
+			}
+			d.tables[i].setRLE(symb)
+			seq.fse = &d.tables[i]
+		case compModeFSE:
+			dec := &d.tables[i]
+			if err := dec.readNCount(&br, uint16(maxTableSymbol[i])); err != nil {
+				return dst, fmt.Errorf("zstd: reading table for %v: %v", tableIndex(i), err)
+			}
+			if err := dec.transform(symbolTableX[i]); err != nil {
+				return dst, fmt.Errorf("zstd: transforming table for %v: %v", tableIndex(i), err)
+			}
+			seq.fse = dec
+		case compModeRepeat:
+			if seq.fse == nil {
+				return dst, fmt.Errorf("zstd: repeat mode for %v with no previous table", tableIndex(i))
+			}
+		}
+		if br.overread() {
+			return dst, io.ErrUnexpectedEOF
+		}
+	}
+	in = br.unread()
+
+	var bitr bitReader
+	if err := bitr.init(in); err != nil {
+		return dst, err
+	}
+	return d.seqs.decode(nSeqs, &bitr, dst)
+}
+
+// A Reader decompresses a zstd stream.
+type Reader struct {
+	d       *Decoder
+	buf     []byte
+	pos     int
+	matches []pack.Match
+	err     error
+}
+
+// NewReader returns a Reader that decompresses zstd data from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: NewDecoder(r)}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.matches, r.err = r.d.NextBlock(r.buf[:0], r.matches[:0])
+		r.pos = 0
+	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// Reset discards the Reader's state and prepares it to read a new stream
+// from src.
+func (r *Reader) Reset(src io.Reader) {
+	r.d.Reset(src)
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.matches = r.matches[:0]
+	r.err = nil
+}
+
+// SetDictionary sets the dictionary that frames may be compressed with.
+// See Decoder.SetDictionary.
+func (r *Reader) SetDictionary(dict []byte) error {
+	return r.d.SetDictionary(dict)
+}
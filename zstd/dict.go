@@ -0,0 +1,135 @@
+package zstd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/huff0"
+)
+
+// ErrUnknownDictionary is returned when a frame was compressed with a
+// dictionary that the Decoder doesn't have.
+var ErrUnknownDictionary = errors.New("zstd: unknown dictionary")
+
+var dictMagic = []byte{0x37, 0xa4, 0x30, 0xec}
+
+// A Dictionary is a preset dictionary for compressing small frames.
+// It contains content that frames can refer back to, and (if it is in the
+// formatted dictionary format) entropy tables and repeat offsets to start
+// each frame with. See
+// https://github.com/facebook/zstd/blob/dev/doc/zstd_compression_format.md#dictionary-format
+type Dictionary struct {
+	// ID identifies the dictionary in frame headers. It is 0 for a raw
+	// content dictionary, which the decoder must be told about out of band.
+	ID uint32
+
+	// Content is the data that frames can refer back to.
+	Content []byte
+
+	// Offsets are the initial values of the repeat offsets.
+	Offsets [3]int
+
+	// literalTable is the encoded Huffman table for literals, and
+	// literalEnc is the same table, ready for the encoder to use.
+	literalTable []byte
+	literalEnc   *huff0.Scratch
+
+	// tables are the FSE tables for the sequences, indexed by tableIndex.
+	// They must not be modified after the dictionary is parsed.
+	tables    [3]fseDecoder
+	hasTables bool
+}
+
+// ParseDictionary parses a dictionary. If b starts with the dictionary magic
+// number, it is parsed as a formatted dictionary; otherwise all of b is used
+// as the content of a raw dictionary.
+func ParseDictionary(b []byte) (*Dictionary, error) {
+	initPredefined()
+	if len(b) < 8 || !bytes.Equal(b[:4], dictMagic) {
+		return &Dictionary{
+			Content: append([]byte(nil), b...),
+			Offsets: [3]int{1, 4, 8},
+		}, nil
+	}
+
+	d := &Dictionary{
+		ID: binary.LittleEndian.Uint32(b[4:8]),
+	}
+	if d.ID == 0 {
+		return nil, errors.New("zstd: dictionary ID 0 is reserved")
+	}
+
+	enc, rest, err := huff0.ReadTable(b[8:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("zstd: reading dictionary literals table: %v", err)
+	}
+	d.literalEnc = enc
+	d.literalTable = append([]byte(nil), b[8:len(b)-len(rest)]...)
+
+	br := byteReader{b: rest}
+	for _, i := range []tableIndex{tableOffsets, tableMatchLengths, tableLiteralLengths} {
+		dec := &d.tables[i]
+		if err := dec.readNCount(&br, uint16(maxTableSymbol[i])); err != nil {
+			return nil, fmt.Errorf("zstd: reading dictionary table for %v: %v", i, err)
+		}
+		if br.overread() {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err := dec.transform(symbolTableX[i]); err != nil {
+			return nil, fmt.Errorf("zstd: transforming dictionary table for %v: %v", i, err)
+		}
+	}
+	d.hasTables = true
+
+	if br.remain() < 12 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	for i := range d.Offsets {
+		d.Offsets[i] = int(br.Uint32())
+		br.advance(4)
+	}
+	d.Content = append([]byte(nil), br.unread()...)
+	for _, o := range d.Offsets {
+		if o <= 0 || o > len(d.Content) {
+			return nil, fmt.Errorf("zstd: dictionary repeat offset (%d) out of range", o)
+		}
+	}
+	return d, nil
+}
+
+// setEncoders sets up the FSE encoders in coders to repeat the dictionary's
+// tables. Tables that are too big for the encoder are skipped.
+func (d *Dictionary) setEncoders(coders *seqCoders) {
+	if !d.hasTables {
+		return
+	}
+	for _, t := range []struct {
+		i   tableIndex
+		enc *fseEncoder
+	}{
+		{tableLiteralLengths, coders.llPrev},
+		{tableOffsets, coders.ofPrev},
+		{tableMatchLengths, coders.mlPrev},
+	} {
+		dec := &d.tables[t.i]
+		if dec.actualTableLog > maxEncTableLog {
+			continue
+		}
+		enc := t.enc
+		copy(enc.norm[:], dec.norm[:dec.symbolLen])
+		enc.symbolLen = dec.symbolLen
+		enc.actualTableLog = dec.actualTableLog
+		enc.useRLE = false
+		enc.preDefined = false
+		enc.reUsed = false
+		if err := enc.buildCTable(); err != nil {
+			enc.symbolLen = 0
+			continue
+		}
+		enc.setBits(bitTables[t.i])
+		enc.reUsed = true
+	}
+}
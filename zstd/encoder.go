@@ -1,12 +1,93 @@
 package zstd
 
 import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+
 	"github.com/andybalholm/pack"
+	"github.com/pierrec/xxHash/xxHash64"
 )
 
+// EncoderOptions control the frame header that an Encoder writes.
+type EncoderOptions struct {
+	// Checksum adds an XXH64 checksum of the content to the end of each
+	// frame.
+	Checksum bool
+
+	// ContentSize is the size of the uncompressed data in each frame, if
+	// it is known in advance. If it is zero, the size isn't declared in the
+	// frame header. If a different amount of data is encoded, the Encoder
+	// stops writing and reports an error from Err (so pack.Writer returns
+	// it from Write or Close).
+	ContentSize int64
+
+	// WindowSize is the window size declared in the frame header: how far
+	// back matches may refer, and how much history a decoder needs to keep.
+	// It is rounded up to a power of 2 between 1 KB and 1 GB. It should be
+	// at least the MatchFinder's MaxDistance; the WindowSize function
+	// calculates it. The default is 8 MB.
+	WindowSize int
+
+	// SingleSegment writes frames whose window is the whole frame, with the
+	// content size in the header instead of the window size. This saves a
+	// byte for small payloads, but the decoder needs to buffer the whole
+	// frame. It is ignored unless ContentSize is set, and no more than the
+	// largest WindowSize (1 GB).
+	SingleSegment bool
+}
+
+// singleSegment reports whether single-segment frames will be written.
+func (o *EncoderOptions) singleSegment() bool {
+	return o.SingleSegment && o.ContentSize > 0 && o.ContentSize <= 1<<30
+}
+
+// windowSize returns the window size that will be written in the frame
+// header.
+func (o *EncoderOptions) windowSize() int {
+	if o.singleSegment() {
+		return int(o.ContentSize)
+	}
+	if o.WindowSize == 0 {
+		return 1 << 23
+	}
+	return WindowSize(o.WindowSize)
+}
+
+// WindowSize returns the smallest value for EncoderOptions.WindowSize that
+// allows matches up to maxDistance bytes back, such as a MatchFinder's
+// MaxDistance. The result is limited to 1 GB.
+func WindowSize(maxDistance int) int {
+	size := 1 << 10
+	for size < maxDistance && size < 1<<30 {
+		size <<= 1
+	}
+	return size
+}
+
 type Encoder struct {
+	Options EncoderOptions
+
 	block       *blockEnc
 	wroteHeader bool
+
+	// hasher computes the content checksum, if Options.Checksum is set.
+	hasher hash.Hash64
+
+	// encoded is the number of bytes encoded in the current frame, to
+	// check against Options.ContentSize.
+	encoded int64
+
+	err error
+
+	// costs are the estimates used by LiteralCost and MatchCost.
+	costs costs
+
+	// matchBuf holds the matches for the first part of a block that is
+	// being split.
+	matchBuf []pack.Match
+
+	dict *Dictionary
 }
 
 func (e *Encoder) Reset() {
@@ -18,23 +99,124 @@ func (e *Encoder) Reset() {
 	}
 	e.block.initNewEncode()
 	e.wroteHeader = false
+	e.costs.initialized = false
+	e.err = nil
+}
+
+// Err returns the error that stopped e from encoding, if any: a ContentSize
+// that doesn't match the amount of data.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+func (e *Encoder) writeHeader(dst []byte) []byte {
+	fh := frameHeader{
+		WindowSize:    uint32(e.Options.windowSize()),
+		SingleSegment: e.Options.singleSegment(),
+		Checksum:      e.Options.Checksum,
+	}
+	if e.Options.ContentSize > 0 {
+		fh.ContentSize = uint64(e.Options.ContentSize)
+	}
+	if e.Options.Checksum {
+		if e.hasher == nil {
+			e.hasher = xxHash64.New(0)
+		} else {
+			e.hasher.Reset()
+		}
+	}
+	if e.dict != nil {
+		fh.DictID = e.dict.ID
+	}
+	dst, _ = fh.appendTo(dst)
+	e.block.initNewEncode()
+	if e.dict != nil {
+		for i, o := range e.dict.Offsets {
+			e.block.recentOffsets[i] = uint32(o)
+		}
+		e.block.dictLitEnc = e.dict.literalEnc
+		e.dict.setEncoders(&e.block.coders)
+	}
+	e.encoded = 0
+	e.wroteHeader = true
+	return dst
 }
 
+// SetDictionary sets a dictionary for e to use, starting with the next
+// frame. It may be either a formatted dictionary (whose ID is written in the
+// frame header, and whose entropy tables and repeat offsets are used), or raw
+// content. It returns the dictionary's content, for use by the MatchFinder.
+// If dict is nil, e stops using a dictionary.
+func (e *Encoder) SetDictionary(dict []byte) (content []byte, err error) {
+	if dict == nil {
+		e.dict = nil
+		return nil, nil
+	}
+	d, err := ParseDictionary(dict)
+	if err != nil {
+		return nil, err
+	}
+	e.dict = d
+	return d.Content, nil
+}
+
+// Limits returns the limits on block size, match length, and distance for
+// the frames that e writes, based on the window size in e.Options.
+func (e *Encoder) Limits() pack.Limits {
+	window := e.Options.windowSize()
+	blockSize := maxCompressedBlockSize
+	if window < blockSize {
+		blockSize = window
+	}
+	return pack.Limits{
+		BlockSize:   blockSize,
+		MinLength:   3,
+		MaxLength:   maxMatchLen,
+		MaxDistance: window,
+	}
+}
+
+// Encode appends src to dst as one or more zstd blocks, starting a new frame
+// if necessary. If src is larger than Limits().BlockSize, it is split into
+// several blocks.
 func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
 	initPredefined()
+	if e.err != nil {
+		return dst
+	}
 	if e.block == nil {
 		e.block = new(blockEnc)
 		e.block.init()
-	} else {
-		e.block.reset(nil)
 	}
-
 	if !e.wroteHeader {
-		dst, _ = frameHeader{WindowSize: 1 << 23}.appendTo(dst)
-		e.block.initNewEncode()
-		e.wroteHeader = true
+		dst = e.writeHeader(dst)
+	}
+	if size := e.Options.ContentSize; size > 0 {
+		n := e.encoded + int64(len(src))
+		if n > size || lastBlock && n < size {
+			e.err = fmt.Errorf("zstd: ContentSize is %d, but %d bytes were written", size, n)
+			return dst
+		}
 	}
 
+	// Blocks that are bigger than the window (or the format's maximum
+	// block size) are split.
+	blockSize := e.Limits().BlockSize
+	for len(src) > blockSize {
+		var rest []pack.Match
+		e.matchBuf, rest = splitMatches(e.matchBuf[:0], matches, blockSize)
+		dst = e.encodeBlock(dst, src[:blockSize], e.matchBuf, false)
+		src = src[blockSize:]
+		matches = rest
+	}
+	return e.encodeBlock(dst, src, matches, lastBlock)
+}
+
+// encodeBlock appends src to dst as a single zstd block.
+func (e *Encoder) encodeBlock(dst []byte, src []byte, matches []pack.Match, lastBlock bool) []byte {
+	e.block.reset(nil)
+	e.encoded += int64(len(src))
+
 	blk := e.block
 
 	blk.pushOffsets()
@@ -59,6 +241,11 @@ func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock
 		pos += m.Unmatched + m.Length
 	}
 
+	if !e.costs.initialized {
+		e.costs.init()
+	}
+	e.costs.update(blk.literals, blk.sequences)
+
 	err := blk.encode(src, false, false)
 	switch err {
 	case errIncompressible:
@@ -73,5 +260,66 @@ func (e *Encoder) Encode(dst []byte, src []byte, matches []pack.Match, lastBlock
 		panic(err)
 	}
 
-	return append(dst, e.block.output...)
+	dst = append(dst, e.block.output...)
+	if e.Options.Checksum {
+		e.hasher.Write(src)
+		if lastBlock {
+			dst = binary.LittleEndian.AppendUint32(dst, uint32(e.hasher.Sum64()))
+		}
+	}
+	return dst
+}
+
+// splitMatches appends the matches that cover the first n bytes to dst,
+// and returns the rest. A match that crosses the boundary is split in two;
+// a part that is too short to encode as a match is turned into literals.
+func splitMatches(dst, matches []pack.Match, n int) (first, rest []pack.Match) {
+	pos := 0
+	for i, m := range matches {
+		end := pos + m.Unmatched + m.Length
+		if end <= n {
+			dst = append(dst, m)
+			pos = end
+			continue
+		}
+		rest = append(rest, matches[i:]...)
+
+		if pos+m.Unmatched >= n {
+			// The boundary is in the literals.
+			dst = append(dst, pack.Match{Unmatched: n - pos})
+			rest[0].Unmatched -= n - pos
+			return dst, rest
+		}
+
+		head, tail := n-pos-m.Unmatched, end-n
+		if head >= zstdMinMatch {
+			dst = append(dst, pack.Match{Unmatched: m.Unmatched, Length: head, Distance: m.Distance}, pack.Match{})
+		} else {
+			dst = append(dst, pack.Match{Unmatched: m.Unmatched + head})
+		}
+		switch {
+		case tail >= zstdMinMatch:
+			rest[0] = pack.Match{Length: tail, Distance: m.Distance}
+		case len(rest) > 1:
+			rest = rest[1:]
+			rest[0].Unmatched += tail
+		default:
+			rest[0] = pack.Match{Unmatched: tail}
+		}
+		return dst, rest
+	}
+	return dst, nil
+}
+
+// Flush makes sure that the frame header has been written. Each call to Encode
+// produces a complete zstd block, so no other sync marker is needed.
+func (e *Encoder) Flush(dst []byte) []byte {
+	if !e.wroteHeader {
+		if e.block == nil {
+			e.block = new(blockEnc)
+			e.block.init()
+		}
+		dst = e.writeHeader(dst)
+	}
+	return dst
 }
@@ -0,0 +1,300 @@
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/andybalholm/pack"
+	"github.com/pierrec/xxHash/xxHash64"
+)
+
+// ErrSeekTable is returned when a stream doesn't end with a valid seek
+// table.
+var ErrSeekTable = errors.New("zstd: invalid seek table")
+
+const (
+	seekTableMagic   = 0x8F92EAB1
+	seekTableVariant = 0xE
+	seekFooterSize   = 9
+
+	// maxSeekableFrameSize is the largest uncompressed frame size that the
+	// reference implementation allows.
+	maxSeekableFrameSize = 1 << 30
+)
+
+// AppendSkippableFrame appends a skippable frame containing data to dst.
+// Decoders ignore skippable frames, so they can be used to store metadata
+// alongside compressed data. variant (0-15) is added to the frame's magic
+// number, to help tell different kinds of skippable frames apart.
+func AppendSkippableFrame(dst []byte, variant uint8, data []byte) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, skippableFrameMagic+uint32(variant&0xf))
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(data)))
+	return append(dst, data...)
+}
+
+// A SeekableWriter writes data in the zstd seekable format: a series of
+// independent frames, each holding a fixed amount of uncompressed data,
+// followed by a seek table in a skippable frame. Any zstd decoder can
+// decompress the whole stream, and a SeekableReader can decompress any part
+// of it without decompressing what comes before. See
+// https://github.com/facebook/zstd/blob/dev/contrib/seekable_format/zstd_seekable_compression_format.md
+type SeekableWriter struct {
+	w         *pack.Writer
+	dest      countingWriter
+	frameSize int
+
+	// n is the amount of uncompressed data in the current frame.
+	n      int
+	hasher hash.Hash64
+	table  []byte
+	frames uint32
+}
+
+// NewSeekableWriter returns a SeekableWriter that writes to w, using mf to
+// find matches. Each frame (except the last) holds frameSize bytes of
+// uncompressed data (1 MB if frameSize is 0, and at most 1 GB). Smaller
+// frames allow more efficient random access, but compress less well.
+func NewSeekableWriter(w io.Writer, mf pack.MatchFinder, frameSize int) *SeekableWriter {
+	if frameSize <= 0 {
+		frameSize = 1 << 20
+	}
+	if frameSize > maxSeekableFrameSize {
+		frameSize = maxSeekableFrameSize
+	}
+	s := &SeekableWriter{
+		dest:      countingWriter{w: w},
+		frameSize: frameSize,
+		hasher:    xxHash64.New(0),
+	}
+	s.w = &pack.Writer{
+		Dest:        &s.dest,
+		MatchFinder: mf,
+		Encoder:     new(Encoder),
+		BlockSize:   1 << 16,
+	}
+	return s
+}
+
+func (s *SeekableWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > s.frameSize-s.n {
+			chunk = chunk[:s.frameSize-s.n]
+		}
+		if _, err := s.w.Write(chunk); err != nil {
+			return n, err
+		}
+		s.hasher.Write(chunk)
+		s.n += len(chunk)
+		n += len(chunk)
+		p = p[len(chunk):]
+
+		if s.n == s.frameSize {
+			if err := s.endFrame(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// endFrame finishes the current frame, and adds it to the seek table.
+func (s *SeekableWriter) endFrame() error {
+	if err := s.w.Close(); err != nil {
+		return err
+	}
+	s.table = binary.LittleEndian.AppendUint32(s.table, uint32(s.dest.n))
+	s.table = binary.LittleEndian.AppendUint32(s.table, uint32(s.n))
+	s.table = binary.LittleEndian.AppendUint32(s.table, uint32(s.hasher.Sum64()))
+	s.frames++
+
+	s.dest.n = 0
+	s.n = 0
+	s.hasher.Reset()
+	s.w.Reset(&s.dest)
+	return nil
+}
+
+// Close finishes the last frame and writes the seek table. It doesn't close
+// the underlying io.Writer.
+func (s *SeekableWriter) Close() error {
+	if s.n > 0 {
+		if err := s.endFrame(); err != nil {
+			return err
+		}
+	}
+	// The footer: the number of frames, a descriptor byte with the
+	// checksum flag set, and the magic number.
+	s.table = binary.LittleEndian.AppendUint32(s.table, s.frames)
+	s.table = append(s.table, 0x80)
+	s.table = binary.LittleEndian.AppendUint32(s.table, seekTableMagic)
+	_, err := s.dest.w.Write(AppendSkippableFrame(nil, seekTableVariant, s.table))
+	return err
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (n int, err error) {
+	n, err = c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// A SeekableReader provides random access to data in the zstd seekable
+// format, such as the output of SeekableWriter. It is safe for concurrent
+// use. To read the data sequentially, wrap it in an io.SectionReader.
+type SeekableReader struct {
+	r      io.ReaderAt
+	frames []seekableFrame
+	size   int64
+
+	// mu protects the decompressor and the cached frame.
+	mu     sync.Mutex
+	dec    *Reader
+	cached int
+	buf    []byte
+}
+
+// seekableFrame is an entry in the seek table, with the frame's position in
+// the compressed and uncompressed streams.
+type seekableFrame struct {
+	offset, compressedSize  int64
+	start, decompressedSize int64
+	checksum                uint32
+	hasChecksum             bool
+}
+
+// NewSeekableReader returns a SeekableReader that reads from r, which holds
+// size bytes of compressed data ending with a seek table.
+func NewSeekableReader(r io.ReaderAt, size int64) (*SeekableReader, error) {
+	var footer [seekFooterSize]byte
+	if size < 8+seekFooterSize {
+		return nil, ErrSeekTable
+	}
+	if _, err := r.ReadAt(footer[:], size-seekFooterSize); err != nil {
+		return nil, noEOF(err)
+	}
+	if binary.LittleEndian.Uint32(footer[5:]) != seekTableMagic || footer[4]&0x7c != 0 {
+		return nil, ErrSeekTable
+	}
+	numFrames := int64(binary.LittleEndian.Uint32(footer[:4]))
+	hasChecksum := footer[4]&0x80 != 0
+	entrySize := int64(8)
+	if hasChecksum {
+		entrySize = 12
+	}
+
+	tableSize := numFrames*entrySize + seekFooterSize
+	tableStart := size - tableSize - 8
+	if tableStart < 0 {
+		return nil, ErrSeekTable
+	}
+	table := make([]byte, tableSize+8)
+	if _, err := r.ReadAt(table, tableStart); err != nil {
+		return nil, noEOF(err)
+	}
+	if binary.LittleEndian.Uint32(table) != skippableFrameMagic+seekTableVariant ||
+		int64(binary.LittleEndian.Uint32(table[4:])) != tableSize {
+		return nil, ErrSeekTable
+	}
+
+	s := &SeekableReader{
+		r:      r,
+		frames: make([]seekableFrame, numFrames),
+		cached: -1,
+	}
+	var offset int64
+	entries := table[8:]
+	for i := range s.frames {
+		e := entries[int64(i)*entrySize:]
+		f := seekableFrame{
+			offset:           offset,
+			compressedSize:   int64(binary.LittleEndian.Uint32(e)),
+			start:            s.size,
+			decompressedSize: int64(binary.LittleEndian.Uint32(e[4:])),
+			hasChecksum:      hasChecksum,
+		}
+		if hasChecksum {
+			f.checksum = binary.LittleEndian.Uint32(e[8:])
+		}
+		s.frames[i] = f
+		offset += f.compressedSize
+		s.size += f.decompressedSize
+	}
+	if offset > tableStart {
+		return nil, ErrSeekTable
+	}
+	return s, nil
+}
+
+// Size returns the size of the uncompressed data.
+func (s *SeekableReader) Size() int64 {
+	return s.size
+}
+
+// ReadAt decompresses len(p) bytes starting at offset off in the
+// uncompressed data, decompressing only the frames that hold them.
+func (s *SeekableReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("zstd: negative offset")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := sort.Search(len(s.frames), func(i int) bool {
+		return s.frames[i].start+s.frames[i].decompressedSize > off
+	})
+	for n < len(p) && i < len(s.frames) {
+		if err := s.loadFrame(i); err != nil {
+			return n, err
+		}
+		c := copy(p[n:], s.buf[off+int64(n)-s.frames[i].start:])
+		n += c
+		i++
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// loadFrame decompresses frame i into s.buf, unless it is already there.
+func (s *SeekableReader) loadFrame(i int) error {
+	if s.cached == i {
+		return nil
+	}
+	s.cached = -1
+	f := s.frames[i]
+	src := io.NewSectionReader(s.r, f.offset, f.compressedSize)
+	if s.dec == nil {
+		s.dec = NewReader(src)
+	} else {
+		s.dec.Reset(src)
+	}
+
+	if int64(cap(s.buf)) < f.decompressedSize {
+		s.buf = make([]byte, f.decompressedSize)
+	}
+	s.buf = s.buf[:f.decompressedSize]
+	if _, err := io.ReadFull(s.dec, s.buf); err != nil {
+		return noEOF(err)
+	}
+	// The frame shouldn't have any more data than the seek table says.
+	var extra [1]byte
+	if n, _ := s.dec.Read(extra[:]); n != 0 {
+		return ErrSeekTable
+	}
+	if f.hasChecksum && uint32(xxHash64.Checksum(s.buf, 0)) != f.checksum {
+		return ErrChecksum
+	}
+	s.cached = i
+	return nil
+}
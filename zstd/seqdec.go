@@ -5,7 +5,9 @@
 package zstd
 
 import (
+	"errors"
 	"fmt"
+	"io"
 )
 
 type seq struct {
@@ -36,3 +38,159 @@ const (
 	compModeFSE
 	compModeRepeat
 )
+
+type sequenceDec struct {
+	// decoder keeps track of the current state and updates it from the bitstream.
+	fse    *fseDecoder
+	state  fseState
+	repeat bool
+}
+
+// init the state of the decoder with input from stream.
+func (s *sequenceDec) init(br *bitReader) error {
+	if s.fse == nil {
+		return errors.New("sequence decoder not defined")
+	}
+	s.state.init(br, s.fse.actualTableLog, s.fse.dt[:1<<s.fse.actualTableLog])
+	return nil
+}
+
+// sequenceDecs contains all 3 sequence decoders and their state.
+type sequenceDecs struct {
+	litLengths   sequenceDec
+	offsets      sequenceDec
+	matchLengths sequenceDec
+	prevOffset   [3]int
+	maxBits      uint8
+}
+
+// initialize all 3 decoders from the stream input.
+func (s *sequenceDecs) initialize(br *bitReader) error {
+	if err := s.litLengths.init(br); err != nil {
+		return errors.New("litLengths:" + err.Error())
+	}
+	if err := s.offsets.init(br); err != nil {
+		return errors.New("offsets:" + err.Error())
+	}
+	if err := s.matchLengths.init(br); err != nil {
+		return errors.New("matchLengths:" + err.Error())
+	}
+	s.maxBits = s.litLengths.fse.maxBits + s.offsets.fse.maxBits + s.matchLengths.fse.maxBits
+	return nil
+}
+
+// decode reads seqs sequences from br and appends them to dst.
+// The offsets are stored the same way the encoder stores them:
+// 1-3 are repeat offset codes, and larger values are the match offset + 3.
+func (s *sequenceDecs) decode(seqs int, br *bitReader, dst []seq) ([]seq, error) {
+	if err := s.initialize(br); err != nil {
+		return dst, err
+	}
+
+	for i := seqs - 1; i >= 0; i-- {
+		if br.overread() {
+			printf("reading sequence %d, exceeded available data\n", seqs-i)
+			return dst, io.ErrUnexpectedEOF
+		}
+
+		// Final will not read from stream.
+		ll, llB := s.litLengths.state.final()
+		ml, mlB := s.matchLengths.state.final()
+		mo, moB := s.offsets.state.final()
+
+		// extra bits are stored in reverse order.
+		br.fill()
+		mo += br.getBits(moB)
+		if s.maxBits > 32 {
+			br.fill()
+		}
+		ml += br.getBits(mlB)
+		ll += br.getBits(llB)
+
+		if moB > 1 {
+			mo += 3
+		} else {
+			// Offset codes 0 and 1 are the repeat offsets.
+			mo++
+		}
+		if ml > maxMatchLen {
+			return dst, fmt.Errorf("match len (%d) bigger than max allowed length", ml)
+		}
+
+		sq := seq{
+			litLen:   uint32(ll),
+			matchLen: uint32(ml - zstdMinMatch),
+			offset:   uint32(mo),
+		}
+		if debugSequences {
+			println("Seq", seqs-i-1, sq)
+		}
+		dst = append(dst, sq)
+
+		if i == 0 {
+			// This is the last sequence, so we shouldn't update state.
+			break
+		}
+		br.fill()
+		s.update(br)
+	}
+
+	if br.overread() {
+		return dst, io.ErrUnexpectedEOF
+	}
+	if !br.finished() {
+		return dst, fmt.Errorf("%d extra bits on block, should be 0", br.remain())
+	}
+	return dst, br.close()
+}
+
+// update states, at least 27 bits must be available.
+func (s *sequenceDecs) update(br *bitReader) {
+	// Max 8 bits
+	s.litLengths.state.next(br)
+	// Max 9 bits
+	s.matchLengths.state.next(br)
+	// Max 8 bits
+	s.offsets.state.next(br)
+}
+
+// adjustOffset converts the offset code from a seq into the actual match
+// offset, and updates the repeat offsets.
+func (s *sequenceDecs) adjustOffset(offset, litLen int) (int, error) {
+	if offset > 3 {
+		s.prevOffset[2] = s.prevOffset[1]
+		s.prevOffset[1] = s.prevOffset[0]
+		s.prevOffset[0] = offset - 3
+		return offset - 3, nil
+	}
+
+	offset--
+	if litLen == 0 {
+		// There is an exception though, when current sequence's literals_length = 0.
+		// In this case, repeated offsets are shifted by one, so an offset_value of 1 means Repeated_Offset2,
+		// an offset_value of 2 means Repeated_Offset3, and an offset_value of 3 means Repeated_Offset1 - 1_byte.
+		offset++
+	}
+
+	if offset == 0 {
+		return s.prevOffset[0], nil
+	}
+	var temp int
+	if offset == 3 {
+		temp = s.prevOffset[0] - 1
+	} else {
+		temp = s.prevOffset[offset]
+	}
+
+	if temp == 0 {
+		// A repeat offset of 1 minus 1 byte isn't valid.
+		return 0, errors.New("zstd: corrupt input: repeat offset is 0")
+	}
+
+	if offset != 1 {
+		s.prevOffset[2] = s.prevOffset[1]
+	}
+	s.prevOffset[1] = s.prevOffset[0]
+	s.prevOffset[0] = temp
+	return temp, nil
+}
@@ -0,0 +1,376 @@
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/andybalholm/pack"
+	"github.com/klauspost/compress/huff0"
+	"github.com/pierrec/xxHash/xxHash64"
+)
+
+// A DictionaryTrainer builds dictionaries from samples of the data they will
+// be used to compress. It selects the dictionary content with the fastCover
+// algorithm from the reference implementation: the samples are divided into
+// epochs, and from each epoch it takes the segment whose d-mers (substrings
+// of DmerSize bytes) occur most often in the samples as a whole. Then it
+// compresses the samples with that content to build the entropy tables.
+type DictionaryTrainer struct {
+	// Size is the maximum size of the dictionary, including its header.
+	// The default is 112640 (110 KB), the same as the zstd command-line
+	// tool. It may be at most 256 KB.
+	Size int
+
+	// ID is the dictionary ID. If it is zero, an ID is derived from the
+	// dictionary content, in the range the format leaves for dictionaries
+	// that aren't publicly registered (32768 to 1<<31 - 1).
+	ID uint32
+
+	// SegmentSize is the length of the segments that the dictionary content
+	// is assembled from. The default is 1024.
+	SegmentSize int
+
+	// DmerSize is the length of the substrings that the trainer counts the
+	// frequency of; it must be 6 or 8. The default is 8.
+	DmerSize int
+}
+
+const (
+	// fastCoverHashLog is the base-2 logarithm of the size of the d-mer
+	// frequency table.
+	fastCoverHashLog = 20
+
+	// fastCoverPasses is the number of times the trainer expects to go
+	// through the epochs before the dictionary is full.
+	fastCoverPasses = 4
+
+	// maxTrainedDictionarySize is the largest dictionary that Train will
+	// build. The statistics for the entropy tables are gathered with a
+	// pack.DualHash, which can refer back to its whole history buffer only
+	// when the history is no more than 256 KB.
+	maxTrainedDictionarySize = 1 << 18
+
+	prime6bytes = 227718039650203
+	prime8bytes = 0xcf1bbcdcb7a56463
+)
+
+// Train builds a dictionary from samples, in the format that ParseDictionary
+// and the reference implementation read.
+func (t *DictionaryTrainer) Train(samples [][]byte) ([]byte, error) {
+	size := t.Size
+	if size == 0 {
+		size = 112640
+	}
+	if size > maxTrainedDictionarySize {
+		return nil, fmt.Errorf("zstd: dictionary size (%d) is larger than the maximum (%d)", size, maxTrainedDictionarySize)
+	}
+	k := t.SegmentSize
+	if k == 0 {
+		k = 1024
+	}
+	d := t.DmerSize
+	if d == 0 {
+		d = 8
+	}
+	if d != 6 && d != 8 {
+		return nil, fmt.Errorf("zstd: invalid d-mer size (%d)", d)
+	}
+
+	var all []byte
+	for _, s := range samples {
+		all = append(all, s...)
+	}
+	if len(all) < 2*k || len(all) < 16 {
+		return nil, errors.New("zstd: not enough training data")
+	}
+
+	fc := &fastCover{
+		samples: all,
+		d:       d,
+		k:       k,
+		freqs:   make([]uint32, 1<<fastCoverHashLog),
+		active:  make([]uint16, 1<<fastCoverHashLog),
+	}
+	fc.countFrequencies()
+	content := fc.buildContent(size)
+	return finalizeDictionary(t.ID, content, samples, size)
+}
+
+// fastCover holds the state for selecting dictionary content.
+type fastCover struct {
+	// samples is all the samples concatenated together.
+	samples []byte
+
+	d, k int
+
+	// freqs is the number of times each d-mer hash occurs in samples.
+	// The entries for d-mers that are already in the dictionary are
+	// cleared, so that they don't count towards later segments.
+	freqs []uint32
+
+	// active is the number of times each d-mer hash occurs in the segment
+	// being considered.
+	active []uint16
+}
+
+func (fc *fastCover) hash(pos int) uint32 {
+	v := binary.LittleEndian.Uint64(fc.samples[pos:])
+	if fc.d == 6 {
+		return uint32(((v << 16) * prime6bytes) >> (64 - fastCoverHashLog))
+	}
+	return uint32((v * prime8bytes) >> (64 - fastCoverHashLog))
+}
+
+// numDmers returns the number of positions that a d-mer can start at. Since
+// the hash function reads 8 bytes, it is the same for both sizes.
+func (fc *fastCover) numDmers() int {
+	return len(fc.samples) - 8 + 1
+}
+
+func (fc *fastCover) countFrequencies() {
+	for i := 0; i < fc.numDmers(); i++ {
+		fc.freqs[fc.hash(i)]++
+	}
+}
+
+// buildContent selects segments for the dictionary content, filling it from
+// the end, so that the most useful segments end up closest to the data.
+func (fc *fastCover) buildContent(size int) []byte {
+	n := fc.numDmers()
+	epochs := size / fc.k / fastCoverPasses
+	if epochs < 1 {
+		epochs = 1
+	}
+	epochSize := n / epochs
+	if minEpochSize := fc.k * 10; epochSize < minEpochSize {
+		epochSize = minEpochSize
+		if epochSize > n {
+			epochSize = n
+		}
+		epochs = n / epochSize
+	}
+	maxZeroScoreRun := epochs >> 3
+	if maxZeroScoreRun > 100 {
+		maxZeroScoreRun = 100
+	}
+	if maxZeroScoreRun < 10 {
+		maxZeroScoreRun = 10
+	}
+
+	content := make([]byte, size)
+	tail := size
+	zeroScoreRun := 0
+	for epoch := 0; tail > 0; epoch = (epoch + 1) % epochs {
+		begin := epoch * epochSize
+		start, end, score := fc.selectSegment(begin, begin+epochSize)
+		if score == 0 {
+			zeroScoreRun++
+			if zeroScoreRun >= maxZeroScoreRun {
+				break
+			}
+			continue
+		}
+		zeroScoreRun = 0
+
+		segmentSize := end - start + fc.d - 1
+		if segmentSize > tail {
+			segmentSize = tail
+		}
+		if segmentSize < fc.d {
+			break
+		}
+		tail -= segmentSize
+		copy(content[tail:], fc.samples[start:start+segmentSize])
+	}
+	return content[tail:]
+}
+
+// selectSegment finds the segment of up to k d-mers between begin and end
+// whose distinct d-mers have the highest total frequency. Then it clears the
+// frequencies of the d-mers in that segment.
+func (fc *fastCover) selectSegment(begin, end int) (bestBegin, bestEnd int, bestScore uint64) {
+	bestBegin, bestEnd = begin, begin
+	activeBegin := begin
+	var score uint64
+	for activeEnd := begin; activeEnd < end; {
+		h := fc.hash(activeEnd)
+		if fc.active[h] == 0 {
+			score += uint64(fc.freqs[h])
+		}
+		fc.active[h]++
+		activeEnd++
+
+		if activeEnd-activeBegin == fc.k+1 {
+			h := fc.hash(activeBegin)
+			fc.active[h]--
+			if fc.active[h] == 0 {
+				score -= uint64(fc.freqs[h])
+			}
+			activeBegin++
+		}
+
+		if score > bestScore {
+			bestBegin, bestEnd, bestScore = activeBegin, activeEnd, score
+		}
+	}
+
+	for ; activeBegin < end; activeBegin++ {
+		fc.active[fc.hash(activeBegin)]--
+	}
+	for i := bestBegin; i < bestEnd; i++ {
+		fc.freqs[fc.hash(i)] = 0
+	}
+	return bestBegin, bestEnd, bestScore
+}
+
+// finalizeDictionary builds a formatted dictionary with content, and entropy
+// tables based on compressing samples with it. If the result would be larger
+// than size, the beginning of the content is trimmed off. If id is 0, an ID
+// is derived from the content.
+func finalizeDictionary(id uint32, content []byte, samples [][]byte, size int) ([]byte, error) {
+	initPredefined()
+	if len(content) < 8 {
+		return nil, errors.New("zstd: dictionary content too short")
+	}
+
+	// Start every count at 1, so that the tables can encode any symbol,
+	// not just the ones that occur in the samples.
+	var literals [256]uint32
+	var ll [maxLLCode + 1]uint32
+	var ml [maxMLCode + 1]uint32
+	var of [maxOffsetBits + 1]uint32
+	for i := range literals {
+		literals[i] = 1
+	}
+	for i := range ll {
+		ll[i] = 1
+	}
+	for i := range ml {
+		ml[i] = 1
+	}
+	maxSample := 0
+	for _, s := range samples {
+		if len(s) > maxSample {
+			maxSample = len(s)
+		}
+	}
+	offcodeMax := highBit(uint32(len(content) + maxCompressedBlockSize))
+	if offcodeMax > maxOffsetBits {
+		offcodeMax = maxOffsetBits
+	}
+	for i := 0; i <= int(offcodeMax); i++ {
+		of[i] = 1
+	}
+
+	// Unlike HashChain, DualHash can find matches anywhere in the
+	// dictionary content, not just in the last 64 KB.
+	mf := &pack.DualHash{
+		MaxDistance: len(content) + maxSample,
+		Parser:      &pack.LazyParser{},
+	}
+	mf.SetDictionary(content)
+	var matches []pack.Match
+	for _, s := range samples {
+		mf.Reset()
+		matches = mf.FindMatches(matches[:0], s)
+		rep := blockEnc{recentOffsets: [3]uint32{1, 4, 8}}
+		pos := 0
+		for _, m := range matches {
+			for _, c := range s[pos : pos+m.Unmatched] {
+				literals[c]++
+			}
+			pos += m.Unmatched + m.Length
+			if m.Length == 0 {
+				continue
+			}
+			ll[llCode(uint32(m.Unmatched))]++
+			ml[mlCode(uint32(m.Length-zstdMinMatch))]++
+			of[ofCode(rep.matchOffset(uint32(m.Distance), uint32(m.Unmatched)))]++
+		}
+	}
+
+	var header []byte
+	header = append(header, dictMagic...)
+	header = append(header, 0, 0, 0, 0)
+
+	table, err := literalTable(&literals)
+	if err != nil {
+		return nil, err
+	}
+	header = append(header, table...)
+
+	for _, counts := range [][]uint32{of[:], ml[:], ll[:]} {
+		if header, err = writeDictionaryTable(header, counts); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, o := range []uint32{1, 4, 8} {
+		header = append(header, byte(o), byte(o>>8), byte(o>>16), byte(o>>24))
+	}
+
+	if len(header)+len(content) > size {
+		if size-len(header) < 8 {
+			return nil, errors.New("zstd: dictionary size too small for the entropy tables")
+		}
+		content = content[len(content)-(size-len(header)):]
+	}
+	if id == 0 {
+		id = uint32(xxHash64.Checksum(content, 0)%(1<<31-32768)) + 32768
+	}
+	binary.LittleEndian.PutUint32(header[4:], id)
+	return append(header, content...), nil
+}
+
+// literalTable returns an encoded Huffman table for literals with the
+// frequencies in counts.
+func literalTable(counts *[256]uint32) ([]byte, error) {
+	// huff0 builds its table from the data to be compressed, so make up some
+	// data with the right proportions.
+	const limit = 1 << 17
+	total := 0
+	for _, n := range counts {
+		total += int(n)
+	}
+	if total > limit {
+		for i, n := range counts {
+			counts[i] = 1 + uint32(uint64(n)*(limit-256)/uint64(total))
+		}
+	}
+	var in []byte
+	for c, n := range counts {
+		for i := uint32(0); i < n; i++ {
+			in = append(in, byte(c))
+		}
+	}
+
+	var s huff0.Scratch
+	if _, _, err := huff0.Compress1X(in, &s); err != nil {
+		return nil, fmt.Errorf("zstd: building dictionary literals table: %v", err)
+	}
+	return s.OutTable, nil
+}
+
+// writeDictionaryTable appends an FSE table description for the symbol
+// frequencies in counts.
+func writeDictionaryTable(dst []byte, counts []uint32) ([]byte, error) {
+	var enc fseEncoder
+	hist := enc.Histogram()
+	maxSymbol, maxCount, total := 0, 0, 0
+	for sym, n := range counts {
+		hist[sym] = n
+		if n > 0 {
+			maxSymbol = sym
+		}
+		if int(n) > maxCount {
+			maxCount = int(n)
+		}
+		total += int(n)
+	}
+	enc.HistogramFinished(uint8(maxSymbol), maxCount)
+	if err := enc.normalizeCount(total); err != nil {
+		return nil, fmt.Errorf("zstd: building dictionary table: %v", err)
+	}
+	return enc.writeCount(dst)
+}
@@ -2,7 +2,10 @@ package zstd
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/andybalholm/pack"
@@ -50,6 +53,35 @@ func TestEncodeSSAP(t *testing.T) {
 	test(t, "../testdata/Isaac.Newton-Opticks.txt", &pack.SimpleSearchAdvancedParsing{MaxDistance: 1 << 18}, 1<<16)
 }
 
+func TestEncodeLiteralsOnly(t *testing.T) {
+	test(t, "../testdata/Isaac.Newton-Opticks.txt", pack.NoMatchFinder{}, 1<<16)
+}
+
+func TestEncodeIncompressible(t *testing.T) {
+	data := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(data)
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: brotli.M0{},
+		Encoder:     &Encoder{},
+		BlockSize:   1 << 16,
+	}
+	w.Write(data)
+	w.Close()
+	sr, err := zstd.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(sr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
 func benchmark(b *testing.B, filename string, m pack.MatchFinder, blockSize int) {
 	b.StopTimer()
 	b.ReportAllocs()
@@ -88,3 +120,630 @@ func BenchmarkEncodeH4(b *testing.B) {
 func BenchmarkEncodeSSAP(b *testing.B) {
 	benchmark(b, "../testdata/Isaac.Newton-Opticks.txt", &pack.SimpleSearchAdvancedParsing{MaxDistance: 1 << 20}, 1<<20)
 }
+
+func TestFlush(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: &brotli.MatchFinder{Hasher: &brotli.H4{}, MaxHistory: 1 << 18, MinHistory: 1 << 16},
+		Encoder:     &Encoder{},
+		BlockSize:   1 << 16,
+	}
+	w.Write(data[:100000])
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	sr, err := zstd.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	partial := make([]byte, 100000)
+	if _, err := io.ReadFull(sr, partial); err != nil {
+		t.Fatalf("error decompressing flushed data: %v", err)
+	}
+	if !bytes.Equal(partial, data[:100000]) {
+		t.Fatal("flushed output doesn't match")
+	}
+
+	// The data is a multiple of the block size now,
+	// so Close will write an empty block.
+	w.Write(data[100000 : 100000+1<<16])
+	w.Flush()
+	w.Close()
+	sr, err = zstd.NewReader(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := ioutil.ReadAll(sr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data[:100000+1<<16]) {
+		t.Fatal("decompressed output doesn't match")
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for level := zstd.SpeedFastest; level <= zstd.SpeedBestCompression; level++ {
+		b := new(bytes.Buffer)
+		w, _ := zstd.NewWriter(b, zstd.WithEncoderLevel(level))
+		w.Write(data[:100000])
+		w.Flush()
+		w.Write(data[100000:])
+		w.Close()
+
+		d := NewDecoder(bytes.NewReader(b.Bytes()))
+		var decompressed []byte
+		var matches []pack.Match
+		var reencoded []byte
+		e := new(Encoder)
+		for {
+			start := len(decompressed)
+			decompressed, matches, err = d.NextBlock(decompressed, matches[:0])
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("error decompressing level %v: %v", level, err)
+			}
+			// Re-encode the block, using the matches from the decoder.
+			reencoded = e.Encode(reencoded, decompressed[start:], matches, false)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed output doesn't match on level %v", level)
+		}
+
+		reencoded = e.Encode(reencoded, nil, nil, true)
+		sr, err := zstd.NewReader(bytes.NewReader(reencoded))
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err = ioutil.ReadAll(sr)
+		if err != nil {
+			t.Fatalf("error decompressing re-encoded level %v: %v", level, err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("re-encoded output doesn't match on level %v", level)
+		}
+	}
+}
+
+func TestCorruptRepeatOffset(t *testing.T) {
+	// With no literals, offset code 3 means the most recent offset minus 1,
+	// which is 0 if the most recent offset is 1.
+	var s sequenceDecs
+	s.prevOffset = [3]int{1, 4, 8}
+	if _, err := s.adjustOffset(3, 0); err == nil {
+		t.Fatal("no error for a repeat offset of 0")
+	}
+}
+
+func TestReader(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Our own output, with several frames and a skippable frame in between.
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: &brotli.MatchFinder{Hasher: &brotli.H4{}, MaxHistory: 1 << 18, MinHistory: 1 << 16},
+		Encoder:     &Encoder{},
+		BlockSize:   1 << 16,
+	}
+	w.Write(data[:100000])
+	w.Close()
+	b.Write([]byte{0x5e, 0x2a, 0x4d, 0x18, 3, 0, 0, 0, 'a', 'b', 'c'})
+	w.Reset(b)
+	w.Write(data[100000:])
+	w.Close()
+
+	enc, _ := zstd.NewWriter(nil)
+	single := enc.EncodeAll(data[:1000], nil)
+	b.Write(single)
+
+	decompressed, err := ioutil.ReadAll(NewReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, append(data[:len(data):len(data)], data[:1000]...)) {
+		t.Fatal("decompressed output doesn't match")
+	}
+
+	corrupted := append([]byte(nil), b.Bytes()...)
+	corrupted[len(corrupted)-2]++
+	_, err = ioutil.ReadAll(NewReader(bytes.NewReader(corrupted)))
+	if err != ErrChecksum {
+		t.Fatalf("got %v with bad checksum, want %v", err, ErrChecksum)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	opticks, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	enc, _ := zstd.NewWriter(nil)
+	compressed := enc.EncodeAll(opticks, nil)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(opticks)))
+	r := NewReader(bytes.NewReader(compressed))
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := data[:1<<16]
+	dict, err := finalizeDictionary(12345, content, [][]byte{data[1<<16 : 1<<17]}, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := &pack.Writer{
+		MatchFinder: &pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}},
+		Encoder:     &Encoder{},
+		BlockSize:   1 << 16,
+	}
+	if err := w.SetDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	kr, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kr.Close()
+	for i := 200000; i < 250000; i += 1000 {
+		record := data[i : i+1000]
+		b := new(bytes.Buffer)
+		w.Reset(b)
+		w.Write(record)
+		w.Close()
+		c := b.Bytes()
+
+		decompressed, err := kr.DecodeAll(c, nil)
+		if err != nil {
+			t.Fatalf("record at %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed, record) {
+			t.Fatalf("record at %d: decompressed output doesn't match", i)
+		}
+
+		r := NewReader(bytes.NewReader(c))
+		if err := r.SetDictionary(dict); err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err = ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("record at %d: %v", i, err)
+		}
+		if !bytes.Equal(decompressed, record) {
+			t.Fatalf("record at %d: output from Decoder doesn't match", i)
+		}
+
+		if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(c))); err != ErrUnknownDictionary {
+			t.Fatalf("got %v decoding without the dictionary, want %v", err, ErrUnknownDictionary)
+		}
+	}
+}
+
+func TestDictionaryTrainer(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var samples, records [][]byte
+	for i := 0; i < 200000; i += 1000 {
+		samples = append(samples, data[i:i+1000])
+	}
+	for i := 200000; i < 250000; i += 1000 {
+		records = append(records, data[i:i+1000])
+	}
+
+	trainer := &DictionaryTrainer{Size: 16384}
+	dict, err := trainer.Train(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dict) > trainer.Size {
+		t.Fatalf("dictionary is %d bytes; want at most %d", len(dict), trainer.Size)
+	}
+	parsed, err := ParseDictionary(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.hasTables || parsed.ID < 32768 {
+		t.Fatalf("got dictionary with ID %d and tables %v", parsed.ID, parsed.hasTables)
+	}
+
+	kr, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kr.Close()
+
+	compress := func(dict []byte) (total int) {
+		w := &pack.Writer{
+			MatchFinder: &pack.HashChain{SearchLen: 16, MaxDistance: 1 << 20, Parser: &pack.LazyParser{}},
+			Encoder:     &Encoder{},
+		}
+		if dict != nil {
+			if err := w.SetDictionary(dict); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for i, r := range records {
+			b := new(bytes.Buffer)
+			w.Reset(b)
+			w.Write(r)
+			w.Close()
+			total += b.Len()
+			decompressed, err := kr.DecodeAll(b.Bytes(), nil)
+			if err != nil {
+				t.Fatalf("record %d: %v", i, err)
+			}
+			if !bytes.Equal(decompressed, r) {
+				t.Fatalf("record %d: decompressed output doesn't match", i)
+			}
+		}
+		return total
+	}
+
+	withDict := compress(dict)
+	without := compress(nil)
+	if withDict >= without {
+		t.Errorf("compressed size with trained dictionary is %d; without it is %d", withDict, without)
+	}
+	t.Logf("with trained dictionary: %d, without: %d", withDict, without)
+
+	// The largest dictionary size
+	for i := 250000; i < 500000; i += 1000 {
+		samples = append(samples, data[i:i+1000])
+	}
+	trainer = &DictionaryTrainer{Size: maxTrainedDictionarySize}
+	dict, err = trainer.Train(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dict) > trainer.Size {
+		t.Fatalf("dictionary is %d bytes; want at most %d", len(dict), trainer.Size)
+	}
+	if _, err := ParseDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&DictionaryTrainer{Size: 400000}).Train(samples); err == nil {
+		t.Fatal("no error for a dictionary size over the maximum")
+	}
+
+	// The offset table should reflect matches from the far end of the
+	// content, about 200 KB back. They are the only matches that far back,
+	// since the rest of the content is zeros.
+	random := make([]byte, 1000)
+	rand.New(rand.NewSource(1)).Read(random)
+	content := append(random, make([]byte, 199000)...)
+	var far [][]byte
+	for i := 300000; i < 320000; i += 1000 {
+		far = append(far, append(data[i:i+200:i+200], random...))
+	}
+	dict, err = finalizeDictionary(0, content, far, maxTrainedDictionarySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err = ParseDictionary(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	of := &parsed.tables[tableOffsets]
+	if code := highBit(200000); of.norm[code] < 1<<(of.actualTableLog-4) {
+		t.Errorf("offset code %d has probability %d/%d", code, of.norm[code], 1<<of.actualTableLog)
+	}
+}
+
+func TestEncoderOptions(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compress := func(data []byte, opts EncoderOptions) []byte {
+		b := new(bytes.Buffer)
+		e := &Encoder{Options: opts}
+		w := &pack.Writer{
+			Dest:        b,
+			MatchFinder: &pack.HashChain{SearchLen: 16, MaxDistance: e.Limits().MaxDistance, Parser: &pack.LazyParser{}},
+			Encoder:     e,
+			BlockSize:   1 << 16,
+		}
+		w.Write(data)
+		w.Close()
+		return b.Bytes()
+	}
+
+	for _, c := range []struct {
+		name string
+		data []byte
+		opts EncoderOptions
+	}{
+		{"default", data, EncoderOptions{}},
+		{"checksum", data, EncoderOptions{Checksum: true, ContentSize: int64(len(data)), WindowSize: 1 << 20}},
+		{"single segment", data[:1000], EncoderOptions{SingleSegment: true, ContentSize: 1000}},
+		{"single segment without content size", data[:100000], EncoderOptions{SingleSegment: true}},
+		// Blocks larger than the window need to be split.
+		{"1 KB window", data[:200000], EncoderOptions{WindowSize: 1 << 10}},
+		{"32 KB window", data[:200000], EncoderOptions{WindowSize: 1 << 15}},
+	} {
+		if l := (&Encoder{Options: c.opts}).Limits(); l.BlockSize == 0 || l.MaxDistance == 0 {
+			t.Errorf("%s: got limits %+v", c.name, l)
+		}
+
+		compressed := compress(c.data, c.opts)
+		var h zstd.Header
+		if err := h.Decode(compressed); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if h.HasCheckSum != c.opts.Checksum || h.FrameContentSize != uint64(c.opts.ContentSize) {
+			t.Errorf("%s: got header with checksum %v and content size %d", c.name, h.HasCheckSum, h.FrameContentSize)
+		}
+		if !c.opts.singleSegment() && h.WindowSize != uint64(c.opts.windowSize()) {
+			t.Errorf("%s: got window size %d, want %d", c.name, h.WindowSize, c.opts.windowSize())
+		}
+
+		kr, err := zstd.NewReader(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := kr.DecodeAll(compressed, nil)
+		kr.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(decompressed, c.data) {
+			t.Fatalf("%s: decompressed output doesn't match", c.name)
+		}
+		decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(decompressed, c.data) {
+			t.Fatalf("%s: output from Decoder doesn't match", c.name)
+		}
+
+		if c.opts.Checksum {
+			compressed[len(compressed)-1]++
+			if _, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed))); err != ErrChecksum {
+				t.Errorf("%s: got %v with bad checksum, want %v", c.name, err, ErrChecksum)
+			}
+		}
+	}
+
+	if single, multi := compress(data[:1000], EncoderOptions{SingleSegment: true, ContentSize: 1000}), compress(data[:1000], EncoderOptions{ContentSize: 1000}); len(single) >= len(multi) {
+		t.Errorf("single-segment frame is %d bytes; regular frame is %d", len(single), len(multi))
+	}
+
+	for _, c := range []struct{ maxDistance, window int }{
+		{1, 1 << 10},
+		{1 << 10, 1 << 10},
+		{1<<10 + 1, 1 << 11},
+		{65535, 1 << 16},
+		{1<<30 + 1, 1 << 30},
+	} {
+		if w := WindowSize(c.maxDistance); w != c.window {
+			t.Errorf("WindowSize(%d) = %d, want %d", c.maxDistance, w, c.window)
+		}
+	}
+
+	// MatchCost should follow the window size.
+	small := &Encoder{Options: EncoderOptions{WindowSize: 1 << 10}}
+	large := &Encoder{Options: EncoderOptions{WindowSize: 1 << 24}}
+	for _, c := range []struct {
+		e        *Encoder
+		distance int
+		ok       bool
+	}{
+		{small, 1 << 10, true},
+		{small, 1<<10 + 1, false},
+		{large, 1<<23 + 1, true},
+		{large, 1<<24 + 1, false},
+	} {
+		cost := c.e.MatchCost(pack.Match{Unmatched: 1, Length: 10, Distance: c.distance}, [4]int{})
+		if ok := !math.IsInf(float64(cost), 1); ok != c.ok {
+			t.Errorf("window %d: MatchCost for distance %d is %v", c.e.Options.WindowSize, c.distance, cost)
+		}
+	}
+
+	// A ContentSize that doesn't match the data is reported by the Writer.
+	for _, size := range []int{999, 1001} {
+		w := &pack.Writer{
+			Dest:        ioutil.Discard,
+			MatchFinder: &pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}},
+			Encoder:     &Encoder{Options: EncoderOptions{ContentSize: 1000}},
+			BlockSize:   500,
+		}
+		_, err := w.Write(data[:size])
+		if err == nil {
+			err = w.Close()
+		}
+		if err == nil {
+			t.Errorf("no error when writing %d bytes with ContentSize 1000", size)
+		}
+	}
+}
+
+// treelessBlocks returns the number of compressed blocks in frame whose
+// literals section repeats the previous Huffman table.
+func treelessBlocks(frame []byte) int {
+	fhd := frame[4]
+	pos := 5
+	if fhd&(1<<5) == 0 {
+		pos++ // window descriptor
+	}
+	pos += []int{0, 1, 2, 4}[fhd&3]
+	fcsSize := []int{0, 2, 4, 8}[fhd>>6]
+	if fhd>>6 == 0 && fhd&(1<<5) != 0 {
+		fcsSize = 1
+	}
+	pos += fcsSize
+
+	n := 0
+	for {
+		bh := uint32(frame[pos]) | uint32(frame[pos+1])<<8 | uint32(frame[pos+2])<<16
+		pos += 3
+		size := int(bh >> 3)
+		switch blockType((bh >> 1) & 3) {
+		case blockTypeRLE:
+			size = 1
+		case blockTypeCompressed:
+			if literalsBlockType(frame[pos]&3) == literalsBlockTreeless {
+				n++
+			}
+		}
+		pos += size
+		if bh&1 != 0 {
+			return n
+		}
+	}
+}
+
+func TestTableReuse(t *testing.T) {
+	// Text with a skewed distribution of letters, so that each block's
+	// literals have about the same statistics.
+	rng := rand.New(rand.NewSource(1))
+	data := make([]byte, 1<<18)
+	for i := range data {
+		data[i] = 'a' + byte(rng.ExpFloat64()*4)%26
+	}
+
+	b := new(bytes.Buffer)
+	w := &pack.Writer{
+		Dest:        b,
+		MatchFinder: pack.NoMatchFinder{},
+		Encoder:     &Encoder{},
+		BlockSize:   1 << 14,
+	}
+	w.Write(data)
+	w.Close()
+	compressed := b.Bytes()
+
+	kr, err := zstd.NewReader(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kr.Close()
+	decompressed, err := kr.DecodeAll(compressed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("output from Decoder doesn't match")
+	}
+
+	// The first block needs a table, but most of the others should be
+	// able to repeat it.
+	if n := treelessBlocks(compressed); n < 8 {
+		t.Errorf("only %d of 16 blocks reused the previous literals table", n)
+	}
+}
+
+func TestSeekable(t *testing.T) {
+	data, err := ioutil.ReadFile("../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := new(bytes.Buffer)
+	w := NewSeekableWriter(b, &pack.HashChain{SearchLen: 16, Parser: &pack.LazyParser{}}, 100000)
+	w.Write(data[:12345])
+	w.Write(data[12345:])
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// A skippable frame at the start shouldn't bother any of the readers.
+	compressed := append(AppendSkippableFrame(nil, 3, []byte("metadata")), b.Bytes()...)
+
+	kr, err := zstd.NewReader(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kr.Close()
+	decompressed, err := kr.DecodeAll(compressed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("decompressed output doesn't match")
+	}
+	decompressed, err = ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Fatal("output from Decoder doesn't match")
+	}
+
+	r, err := NewSeekableReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size() != int64(len(data)) {
+		t.Fatalf("got Size() = %d, want %d", r.Size(), len(data))
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		off := rng.Intn(len(data))
+		p := make([]byte, rng.Intn(250000))
+		n, err := r.ReadAt(p, int64(off))
+		want := data[off:]
+		if len(want) > len(p) {
+			want = want[:len(p)]
+		}
+		if n != len(want) || !bytes.Equal(p[:n], want) {
+			t.Fatalf("ReadAt(%d bytes, %d) returned the wrong data", len(p), off)
+		}
+		if n < len(p) && err != io.EOF || n == len(p) && err != nil {
+			t.Fatalf("ReadAt(%d bytes, %d) returned %v", len(p), off, err)
+		}
+	}
+	if n, err := r.ReadAt(make([]byte, 10), int64(len(data))); n != 0 || err != io.EOF {
+		t.Errorf("ReadAt at the end returned %d, %v", n, err)
+	}
+
+	// Corrupt the checksum of the first frame in the seek table.
+	corrupted := append([]byte(nil), b.Bytes()...)
+	numFrames := (len(data) + 99999) / 100000
+	corrupted[len(corrupted)-9-numFrames*12+8]++
+	r, err = NewSeekableReader(bytes.NewReader(corrupted), int64(len(corrupted)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.ReadAt(make([]byte, 10), 0); err != ErrChecksum {
+		t.Errorf("got %v with bad checksum, want %v", err, ErrChecksum)
+	}
+
+	if _, err := NewSeekableReader(bytes.NewReader(data), int64(len(data))); err != ErrSeekTable {
+		t.Errorf("got %v with no seek table, want %v", err, ErrSeekTable)
+	}
+}